@@ -0,0 +1,42 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// AddPodcastByFeedURLParams is a struct that contains the optional parameters for the AddPodcastByFeedURL method.
+//
+// The optional parameters are:
+type AddPodcastByFeedURLParams struct {
+	// ITunesID : If present, the iTunes ID of the podcast, so it can be associated with the feed.
+	ITunesID podcast.ITunesID
+	// ContentHash : If present, the md5 hash of the feed's content, used to detect duplicate feeds.
+	ContentHash string
+}
+
+// AddPodcastByFeedURL adds a podcast to the index using its feed url.
+//
+// If the feed already exists, its existing feed ID is returned. The API key must have write or publisher
+// permissions; if it does not, the returned error wraps ErrWritePermission.
+//
+// Also accepts optional parameters, see AddPodcastByFeedURLParams for more details.
+func (c *Client) AddPodcastByFeedURL(ctx context.Context, feedURL url.URL, params *AddPodcastByFeedURLParams) (*AddPodcastResult, error) {
+	var response addPodcastResponse
+	urlParams := url.Values{"url": {feedURL.String()}}
+	if params != nil {
+		if params.ITunesID != "" {
+			urlParams.Add("itunesid", string(params.ITunesID))
+		}
+		if params.ContentHash != "" {
+			urlParams.Add("chash", params.ContentHash)
+		}
+	}
+	err := c.api.Get(ctx, "/add/byfeedurl", urlParams, &response)
+	if err != nil {
+		return nil, publisherError(err)
+	}
+	return response.result(), nil
+}
@@ -0,0 +1,85 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+var testAddFeedURL = url.URL{Scheme: "https", Host: "example.com", Path: "/feed.xml"}
+
+func TestAddPodcastByFeedURLRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		params := AddPodcastByFeedURLParams{
+			ITunesID:    "1584274529",
+			ContentHash: "0cc175b9c0f1b6a831c399e269772661",
+		}
+		expectedQuery := url.Values{
+			"url":      {testAddFeedURL.String()},
+			"itunesid": {"1584274529"},
+			"chash":    {"0cc175b9c0f1b6a831c399e269772661"},
+		}
+
+		_, _ = client.AddPodcastByFeedURL(context.Background(), testAddFeedURL, &params)
+		if err := searchServer.ExpectPathAndQuery("/add/byfeedurl", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client returns the new feed ID", func(t *testing.T) {
+		client := GetJSONServer(t, `{"status": "true", "feedId": 7265358, "existed": false, "description": "Feed added."}`)
+		result, err := client.AddPodcastByFeedURL(context.Background(), testAddFeedURL, nil)
+		if err != nil {
+			t.Fatalf("AddPodcastByFeedURL failed: %v", err)
+		}
+		if result.FeedID != 7265358 || result.Existed {
+			t.Errorf("unexpected result: %+v", result)
+		}
+	})
+	t.Run("a failure reported with status false keeps the API's description", func(t *testing.T) {
+		client := GetJSONServer(t, `{"status": "false", "description": "Feed url is not valid."}`)
+		_, err := client.AddPodcastByFeedURL(context.Background(), testAddFeedURL, nil)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.Description != "Feed url is not valid." {
+			t.Fatalf("expected an *APIError with the API's description, got %v", err)
+		}
+		if errors.Is(err, ErrNotFound) {
+			t.Errorf("expected a failure to add a feed not to be ErrNotFound, got %v", err)
+		}
+	})
+	t.Run("a read-only key returns ErrWritePermission", func(t *testing.T) {
+		client := GetResponseServer(t, http.StatusForbidden, `{"status": "false", "description": "This API key does not have permission to add feeds."}`)
+		_, err := client.AddPodcastByFeedURL(context.Background(), testAddFeedURL, nil)
+		if !errors.Is(err, ErrWritePermission) || !errors.Is(err, ErrUnauthorized) {
+			t.Errorf("expected ErrWritePermission, wrapping ErrUnauthorized, for status 403, got %v", err)
+		}
+	})
+	t.Run("bad credentials return ErrUnauthorized, not ErrWritePermission", func(t *testing.T) {
+		for _, body := range []string{
+			`{"status": "false", "description": "Authorization header doesn't match the X-Auth-Key provided."}`,
+			`{"status": "false", "description": "This API key does not have permission to add feeds."}`,
+			``,
+		} {
+			client := GetResponseServer(t, http.StatusUnauthorized, body)
+			_, err := client.AddPodcastByFeedURL(context.Background(), testAddFeedURL, nil)
+			if !errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrWritePermission) {
+				t.Errorf("expected ErrUnauthorized without ErrWritePermission for a 401 with %q, got %v", body, err)
+			}
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).AddPodcastByFeedURL(context.Background(), testAddFeedURL, nil)
+		if err == nil || errors.Is(err, ErrWritePermission) {
+			t.Errorf("Expected a non-permission error when server returns status 500, but got %v", err)
+		}
+	})
+}
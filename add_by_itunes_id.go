@@ -0,0 +1,22 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// AddPodcastByITunesID adds a podcast to the index using its iTunes ID, looking up the feed url from Apple.
+//
+// If the feed already exists, its existing feed ID is returned. The API key must have write or publisher
+// permissions; if it does not, the returned error wraps ErrWritePermission.
+func (c *Client) AddPodcastByITunesID(ctx context.Context, itunesID podcast.ITunesID) (*AddPodcastResult, error) {
+	var response addPodcastResponse
+	params := url.Values{"id": {string(itunesID)}}
+	err := c.api.Get(ctx, "/add/byitunesid", params, &response)
+	if err != nil {
+		return nil, publisherError(err)
+	}
+	return response.result(), nil
+}
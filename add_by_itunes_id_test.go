@@ -0,0 +1,42 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestAddPodcastByITunesIDRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		_, _ = client.AddPodcastByITunesID(context.Background(), "1584274529")
+		if err := searchServer.ExpectPathAndQuery("/add/byitunesid", url.Values{"id": {"1584274529"}}); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client returns the existing feed ID", func(t *testing.T) {
+		client := GetJSONServer(t, `{"status": "true", "feedId": 920666, "existed": true, "description": "Feed already exists."}`)
+		result, err := client.AddPodcastByITunesID(context.Background(), "1584274529")
+		if err != nil {
+			t.Fatalf("AddPodcastByITunesID failed: %v", err)
+		}
+		if result.FeedID != 920666 || !result.Existed {
+			t.Errorf("unexpected result: %+v", result)
+		}
+	})
+	t.Run("a read-only key returns ErrWritePermission", func(t *testing.T) {
+		_, err := GetStatusServer(t, http.StatusForbidden).AddPodcastByITunesID(context.Background(), "1584274529")
+		if !errors.Is(err, ErrWritePermission) {
+			t.Errorf("expected ErrWritePermission, got %v", err)
+		}
+	})
+}
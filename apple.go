@@ -0,0 +1,97 @@
+package podcastindex
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// AppleResult is a podcast as returned by the Apple replacement endpoints, Client.AppleSearch and Client.AppleLookup.
+//
+// Its fields, and their JSON names, match the results of the iTunes Search API, so code written against
+// Apple's response shape can switch to the PodcastIndex with minimal change. Use Podcast to convert it
+// into a Podcast, and ITunesID to get its podcast.ITunesID.
+//
+// https://performance-partners.apple.com/search-api
+type AppleResult struct {
+	WrapperType            string    `json:"wrapperType"`
+	Kind                   string    `json:"kind"`
+	CollectionID           int       `json:"collectionId"`
+	TrackID                int       `json:"trackId"`
+	ArtistName             string    `json:"artistName"`
+	CollectionName         string    `json:"collectionName"`
+	TrackName              string    `json:"trackName"`
+	CollectionCensoredName string    `json:"collectionCensoredName"`
+	TrackCensoredName      string    `json:"trackCensoredName"`
+	CollectionViewURL      string    `json:"collectionViewUrl"`
+	FeedURL                string    `json:"feedUrl"`
+	TrackViewURL           string    `json:"trackViewUrl"`
+	ArtworkURL30           string    `json:"artworkUrl30"`
+	ArtworkURL60           string    `json:"artworkUrl60"`
+	ArtworkURL100          string    `json:"artworkUrl100"`
+	ArtworkURL600          string    `json:"artworkUrl600"`
+	CollectionPrice        float64   `json:"collectionPrice"`
+	TrackPrice             float64   `json:"trackPrice"`
+	ReleaseDate            time.Time `json:"releaseDate"`
+	CollectionExplicitness string    `json:"collectionExplicitness"`
+	TrackExplicitness      string    `json:"trackExplicitness"`
+	TrackCount             int       `json:"trackCount"`
+	Country                string    `json:"country"`
+	Currency               string    `json:"currency"`
+	PrimaryGenreName       string    `json:"primaryGenreName"`
+	ContentAdvisoryRating  string    `json:"contentAdvisoryRating,omitempty"`
+	GenreIDs               []string  `json:"genreIds"`
+	Genres                 []string  `json:"genres"`
+}
+
+// appleResponse is the response from the Apple replacement endpoints on a 200/OK response.
+//
+// Unlike the rest of the API, it matches the shape of the iTunes Search API rather than reporting a status.
+type appleResponse struct {
+	ResultCount int           `json:"resultCount"`
+	Results     []AppleResult `json:"results"`
+}
+
+// ITunesID returns the iTunes ID of the podcast, i.e. its collectionId.
+func (r AppleResult) ITunesID() podcast.ITunesID {
+	return podcast.ITunesID(strconv.Itoa(r.CollectionID))
+}
+
+// Podcast converts the result into a Podcast.
+//
+// Only the fields reported by the Apple replacement endpoints are set; use Client.GetPodcastByITunesID
+// with ITunesID for the full details of the podcast.
+func (r AppleResult) Podcast() (*Podcast, error) {
+	p := &Podcast{
+		Title:          r.CollectionName,
+		Author:         r.ArtistName,
+		ITunesID:       r.ITunesID(),
+		Explicit:       r.CollectionExplicitness == "explicit",
+		EpisodeCount:   r.TrackCount,
+		LastUpdateTime: r.ReleaseDate,
+	}
+	parsedURL, err := url.Parse(r.FeedURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse feedUrl '%s': %w", r.FeedURL, err)
+	}
+	p.URL = *parsedURL
+	parsedURL, err = url.Parse(r.CollectionViewURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse collectionViewUrl '%s': %w", r.CollectionViewURL, err)
+	}
+	p.Link = *parsedURL
+	parsedURL, err = url.Parse(r.ArtworkURL100)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse artworkUrl100 '%s': %w", r.ArtworkURL100, err)
+	}
+	p.Image = *parsedURL
+	parsedURL, err = url.Parse(r.ArtworkURL600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse artworkUrl600 '%s': %w", r.ArtworkURL600, err)
+	}
+	p.Artwork = *parsedURL
+	return p, nil
+}
@@ -0,0 +1,20 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// AppleLookup is a drop-in replacement for the iTunes Lookup API, returning the podcast with the given iTunes ID
+// from the PodcastIndex database in the same shape as Apple's results.
+func (c *Client) AppleLookup(ctx context.Context, itunesID podcast.ITunesID) ([]AppleResult, error) {
+	var response appleResponse
+	params := url.Values{"id": {string(itunesID)}, "entity": {"podcast"}}
+	err := c.api.GetRoot(ctx, "/lookup", params, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Results, nil
+}
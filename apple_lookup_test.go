@@ -0,0 +1,45 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+)
+
+func TestAppleLookupRequest(t *testing.T) {
+	t.Run("the client sends the request to the root of the API host", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL + "/api/1.0/")
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		_, _ = client.AppleLookup(context.Background(), "1584274529")
+		expectedQuery := url.Values{
+			"id":     {"1584274529"},
+			"entity": {"podcast"},
+		}
+		if err := searchServer.ExpectPathAndQuery("/lookup", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).AppleLookup(context.Background(), "1584274529")
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestAppleLookupIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	results, err := client.AppleLookup(context.Background(), "1584274529")
+	if err != nil {
+		t.Fatalf("AppleLookup failed: %v", err)
+	}
+	if len(results) == 0 {
+		t.Fatalf("No results found")
+	}
+}
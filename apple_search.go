@@ -0,0 +1,33 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+)
+
+// AppleSearchParams is a struct that contains the optional parameters for the AppleSearch method.
+//
+// The optional parameters are:
+type AppleSearchParams struct {
+	// Entity is the type of results to return, as in the iTunes Search API; default "podcast"
+	Entity string
+}
+
+// AppleSearch is a drop-in replacement for the iTunes Search API, returning podcasts matching the search term
+// from the PodcastIndex database in the same shape as Apple's results.
+//
+// Also accepts optional parameters, see AppleSearchParams for more details.
+func (c *Client) AppleSearch(ctx context.Context, term string, params *AppleSearchParams) ([]AppleResult, error) {
+	var response appleResponse
+	urlParams := url.Values{"term": {term}}
+	if params != nil {
+		if params.Entity != "" {
+			urlParams.Set("entity", params.Entity)
+		}
+	}
+	err := c.api.GetRoot(ctx, "/search", urlParams, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Results, nil
+}
@@ -0,0 +1,69 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+)
+
+func TestAppleSearchRequest(t *testing.T) {
+	t.Run("the client sends the request to the root of the API host", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL + "/api/1.0/")
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		_, _ = client.AppleSearch(context.Background(), "podcasting 2.0", &AppleSearchParams{Entity: "podcast"})
+		expectedQuery := url.Values{
+			"term":   {"podcasting 2.0"},
+			"entity": {"podcast"},
+		}
+		if err := searchServer.ExpectPathAndQuery("/search", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client keeps the path prefix of a custom base URL", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL + "/mock/api/1.0/")
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		_, _ = client.AppleSearch(context.Background(), "podcasting 2.0", nil)
+		if err := searchServer.ExpectPathAndQuery("/mock/search", url.Values{"term": {"podcasting 2.0"}}); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client returns the apple results", func(t *testing.T) {
+		client := GetJSONServer(t, `{"resultCount": 1, "results": [`+testAppleResult+`]}`)
+		results, err := client.AppleSearch(context.Background(), "podcasting 2.0", nil)
+		if err != nil {
+			t.Fatalf("AppleSearch failed: %v", err)
+		}
+		if len(results) != 1 || results[0].CollectionID != 1584274529 {
+			t.Errorf("unexpected results: %+v", results)
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).AppleSearch(context.Background(), "error test", nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestAppleSearchIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	results, err := client.AppleSearch(context.Background(), "batman university", nil)
+	if err != nil {
+		t.Fatalf("AppleSearch failed: %v", err)
+	}
+	if len(results) == 0 {
+		t.Fatalf("No results found")
+	}
+}
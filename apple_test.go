@@ -0,0 +1,67 @@
+package podcastindex
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+const testAppleResult = `{
+	"wrapperType": "track",
+	"kind": "podcast",
+	"collectionId": 1584274529,
+	"trackId": 1584274529,
+	"artistName": "Podcast Index LLC",
+	"collectionName": "Podcasting 2.0",
+	"trackName": "Podcasting 2.0",
+	"collectionViewUrl": "https://podcasts.apple.com/us/podcast/podcasting-2-0/id1584274529?uo=4",
+	"feedUrl": "https://feeds.podcastindex.org/pc20.xml",
+	"artworkUrl100": "https://example.com/100x100bb.jpg",
+	"artworkUrl600": "https://example.com/600x600bb.jpg",
+	"releaseDate": "2025-04-11T21:23:00Z",
+	"collectionExplicitness": "notExplicit",
+	"trackCount": 215,
+	"primaryGenreName": "Technology",
+	"genreIds": ["1318", "26"],
+	"genres": ["Technology", "Podcasts"]
+}`
+
+func TestAppleResult(t *testing.T) {
+	var result AppleResult
+	if err := json.Unmarshal([]byte(testAppleResult), &result); err != nil {
+		t.Fatalf("failed to unmarshal apple result: %v", err)
+	}
+
+	t.Run("ITunesID is the collectionId", func(t *testing.T) {
+		if result.ITunesID() != "1584274529" {
+			t.Errorf("expected ITunesID 1584274529, got %s", result.ITunesID())
+		}
+	})
+
+	t.Run("Podcast converts the apple fields", func(t *testing.T) {
+		p, err := result.Podcast()
+		if err != nil {
+			t.Fatalf("failed to convert apple result: %v", err)
+		}
+		if p.Title != "Podcasting 2.0" || p.Author != "Podcast Index LLC" || p.ITunesID != "1584274529" {
+			t.Errorf("unexpected podcast: %+v", p)
+		}
+		if p.URL.String() != "https://feeds.podcastindex.org/pc20.xml" {
+			t.Errorf("expected feed URL, got %s", p.URL.String())
+		}
+		if p.Artwork.String() != "https://example.com/600x600bb.jpg" {
+			t.Errorf("expected artworkUrl600 as Artwork, got %s", p.Artwork.String())
+		}
+		if p.Explicit || p.EpisodeCount != 215 || !p.LastUpdateTime.Equal(time.Date(2025, 4, 11, 21, 23, 0, 0, time.UTC)) {
+			t.Errorf("unexpected podcast: %+v", p)
+		}
+	})
+
+	t.Run("Podcast errors on an invalid URL", func(t *testing.T) {
+		invalid := result
+		invalid.FeedURL = ":invalid"
+		if _, err := invalid.Podcast(); err == nil {
+			t.Errorf("expected an error for an invalid feedUrl")
+		}
+	})
+}
@@ -0,0 +1,155 @@
+package podcastindex
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	"github.com/jjgmckenzie/podcastindex/episode"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// DefaultBulkConcurrency is the number of requests bulk helpers make at once when BulkParams.Concurrency is not set.
+const DefaultBulkConcurrency = 4
+
+// BulkParams is a struct that contains the optional parameters for the bulk helpers, e.g. GetPodcastsByFeedIDs.
+type BulkParams struct {
+	// Concurrency is the maximum number of requests made at once; defaults to DefaultBulkConcurrency
+	Concurrency int
+	// Progress (Optional) is called after each identifier is fetched, with the number fetched so far and the total.
+	// It is never called concurrently.
+	Progress func(done, total int)
+}
+
+// BulkResult is the result of fetching a single identifier with a bulk helper.
+type BulkResult[ID any, T any] struct {
+	// Index is the position of the identifier in the slice passed to the bulk helper.
+	Index int
+	// ID is the identifier fetched.
+	ID ID
+	// Value is the result fetched for the identifier, if Err is nil.
+	Value T
+	// Err is the error fetching the identifier, if any. Identifiers not yet fetched when the context is cancelled
+	// have the context's error.
+	Err error
+}
+
+// streamBulk fetches each of ids with fetch, making up to params.Concurrency calls at once,
+// and yields each result as it completes.
+//
+// If ctx is cancelled, identifiers not yet fetched are yielded with the context's error, without calling fetch.
+// If iteration stops early, the calls in progress are cancelled and waited for before returning.
+func streamBulk[ID any, T any](ctx context.Context, ids []ID, params *BulkParams, fetch func(context.Context, ID) (T, error)) iter.Seq[BulkResult[ID, T]] {
+	var bulkParams BulkParams
+	if params != nil {
+		bulkParams = *params
+	}
+	if bulkParams.Concurrency <= 0 {
+		bulkParams.Concurrency = DefaultBulkConcurrency
+	}
+	return func(yield func(BulkResult[ID, T]) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		indexes := make(chan int)
+		results := make(chan BulkResult[ID, T])
+		var workers sync.WaitGroup
+		for range min(bulkParams.Concurrency, len(ids)) {
+			workers.Add(1)
+			go func() {
+				defer workers.Done()
+				for index := range indexes {
+					result := BulkResult[ID, T]{Index: index, ID: ids[index]}
+					if result.Err = ctx.Err(); result.Err == nil {
+						result.Value, result.Err = fetch(ctx, ids[index])
+					}
+					results <- result
+				}
+			}()
+		}
+		go func() {
+			for index := range ids {
+				indexes <- index
+			}
+			close(indexes)
+			workers.Wait()
+			close(results)
+		}()
+
+		done := 0
+		for result := range results {
+			done++
+			if bulkParams.Progress != nil {
+				bulkParams.Progress(done, len(ids))
+			}
+			if !yield(result) {
+				cancel()
+				// drain the remaining results, so every worker exits before returning
+				for range results {
+				}
+				return
+			}
+		}
+	}
+}
+
+// getBulk fetches each of ids with fetch, as streamBulk, and returns the results in the order of ids.
+func getBulk[ID any, T any](ctx context.Context, ids []ID, params *BulkParams, fetch func(context.Context, ID) (T, error)) []BulkResult[ID, T] {
+	results := make([]BulkResult[ID, T], len(ids))
+	for result := range streamBulk(ctx, ids, params, fetch) {
+		results[result.Index] = result
+	}
+	return results
+}
+
+// GetPodcastsByFeedIDs calls GetPodcastByFeedID for each of feedIDs, making up to params.Concurrency requests at once.
+//
+// Also accepts optional parameters, see BulkParams for more details.
+//
+// Returns: a result for each feed ID, in the order of feedIDs, holding the podcast or the error fetching it
+func (c *Client) GetPodcastsByFeedIDs(ctx context.Context, feedIDs []podcast.ID, params *BulkParams) []BulkResult[podcast.ID, *Podcast] {
+	return getBulk(ctx, feedIDs, params, c.GetPodcastByFeedID)
+}
+
+// StreamPodcastsByFeedIDs calls GetPodcastByFeedID for each of feedIDs, making up to params.Concurrency requests at
+// once, and yields each result as it completes. Stopping iteration cancels the requests in progress.
+//
+// Also accepts optional parameters, see BulkParams for more details.
+func (c *Client) StreamPodcastsByFeedIDs(ctx context.Context, feedIDs []podcast.ID, params *BulkParams) iter.Seq[BulkResult[podcast.ID, *Podcast]] {
+	return streamBulk(ctx, feedIDs, params, c.GetPodcastByFeedID)
+}
+
+// GetPodcastsByITunesIDs calls GetPodcastByITunesID for each of itunesIDs, making up to params.Concurrency requests
+// at once.
+//
+// Also accepts optional parameters, see BulkParams for more details.
+//
+// Returns: a result for each iTunes ID, in the order of itunesIDs, holding the podcast or the error fetching it
+func (c *Client) GetPodcastsByITunesIDs(ctx context.Context, itunesIDs []podcast.ITunesID, params *BulkParams) []BulkResult[podcast.ITunesID, *Podcast] {
+	return getBulk(ctx, itunesIDs, params, c.GetPodcastByITunesID)
+}
+
+// StreamPodcastsByITunesIDs calls GetPodcastByITunesID for each of itunesIDs, making up to params.Concurrency
+// requests at once, and yields each result as it completes. Stopping iteration cancels the requests in progress.
+//
+// Also accepts optional parameters, see BulkParams for more details.
+func (c *Client) StreamPodcastsByITunesIDs(ctx context.Context, itunesIDs []podcast.ITunesID, params *BulkParams) iter.Seq[BulkResult[podcast.ITunesID, *Podcast]] {
+	return streamBulk(ctx, itunesIDs, params, c.GetPodcastByITunesID)
+}
+
+// GetEpisodesByIDs calls GetEpisodeByID for each of episodeIDs, making up to params.Concurrency requests at once.
+//
+// Also accepts optional parameters, see BulkParams for more details.
+//
+// Returns: a result for each episode ID, in the order of episodeIDs, holding the episode or the error fetching it
+func (c *Client) GetEpisodesByIDs(ctx context.Context, episodeIDs []episode.ID, params *BulkParams) []BulkResult[episode.ID, *Episode] {
+	return getBulk(ctx, episodeIDs, params, c.GetEpisodeByID)
+}
+
+// StreamEpisodesByIDs calls GetEpisodeByID for each of episodeIDs, making up to params.Concurrency requests at once,
+// and yields each result as it completes. Stopping iteration cancels the requests in progress.
+//
+// Also accepts optional parameters, see BulkParams for more details.
+func (c *Client) StreamEpisodesByIDs(ctx context.Context, episodeIDs []episode.ID, params *BulkParams) iter.Seq[BulkResult[episode.ID, *Episode]] {
+	return streamBulk(ctx, episodeIDs, params, c.GetEpisodeByID)
+}
@@ -0,0 +1,166 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/episode"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// getBulkServer returns a client for a server answering lookups by ID with the ID requested, or "status": "false"
+// for ID 0, recording the most requests it handled at once.
+func getBulkServer(t *testing.T, delay time.Duration) (*Client, *atomic.Int32, *atomic.Int32) {
+	t.Helper()
+	var inFlight, maxInFlight, requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for previous := maxInFlight.Load(); current > previous && !maxInFlight.CompareAndSwap(previous, current); previous = maxInFlight.Load() {
+		}
+		time.Sleep(delay)
+		id := r.URL.Query().Get("id")
+		switch {
+		case id == "0":
+			_, _ = w.Write([]byte(`{"status": "false", "description": "No feeds match this id."}`))
+		case r.URL.Path == "/episodes/byid":
+			_, _ = fmt.Fprintf(w, `{"status": "true", "episode": {"id": %s}}`, id)
+		default:
+			_, _ = fmt.Fprintf(w, `{"status": "true", "feed": {"id": %s, "itunesId": %s}}`, id, id)
+		}
+	}))
+	t.Cleanup(server.Close)
+	serverURL, _ := url.Parse(server.URL)
+	return NewClient(NewClientOptions{BaseURL: serverURL}), &maxInFlight, &requests
+}
+
+func TestGetPodcastsByFeedIDs(t *testing.T) {
+	client, maxInFlight, _ := getBulkServer(t, 10*time.Millisecond)
+	feedIDs := []podcast.ID{5, 0, 3, 1, 4, 2, 6, 7}
+	var progress []int
+	results := client.GetPodcastsByFeedIDs(context.Background(), feedIDs, &BulkParams{
+		Concurrency: 3,
+		Progress: func(done, total int) {
+			if total != len(feedIDs) {
+				t.Errorf("expected a total of %d, got %d", len(feedIDs), total)
+			}
+			progress = append(progress, done)
+		},
+	})
+
+	t.Run("returns a result per ID in input order", func(t *testing.T) {
+		if len(results) != len(feedIDs) {
+			t.Fatalf("expected %d results, got %d", len(feedIDs), len(results))
+		}
+		for i, result := range results {
+			if result.Index != i || result.ID != feedIDs[i] {
+				t.Errorf("expected result %d to be for %d, got %+v", i, feedIDs[i], result)
+			}
+			if feedIDs[i] == 0 {
+				if !errors.Is(result.Err, ErrNotFound) || result.Value != nil {
+					t.Errorf("expected ErrNotFound for feed 0, got %+v", result)
+				}
+			} else if result.Err != nil || result.Value == nil || result.Value.ID != feedIDs[i] {
+				t.Errorf("expected podcast %d, got %+v", feedIDs[i], result)
+			}
+		}
+	})
+	t.Run("limits concurrency", func(t *testing.T) {
+		if maxInFlight.Load() > 3 {
+			t.Errorf("expected at most 3 requests at once, got %d", maxInFlight.Load())
+		}
+	})
+	t.Run("reports progress", func(t *testing.T) {
+		if len(progress) != len(feedIDs) || progress[len(progress)-1] != len(feedIDs) {
+			t.Errorf("expected progress to count to %d, got %v", len(feedIDs), progress)
+		}
+	})
+}
+
+func TestStreamPodcastsByFeedIDs(t *testing.T) {
+	t.Run("yields every result", func(t *testing.T) {
+		client, _, _ := getBulkServer(t, 0)
+		seen := map[podcast.ID]bool{}
+		for result := range client.StreamPodcastsByFeedIDs(context.Background(), []podcast.ID{1, 2, 3}, nil) {
+			if result.Err != nil {
+				t.Fatal(result.Err)
+			}
+			seen[result.Value.ID] = true
+		}
+		if len(seen) != 3 {
+			t.Errorf("expected 3 podcasts, got %v", seen)
+		}
+	})
+	t.Run("stopping iteration stops fetching", func(t *testing.T) {
+		client, _, requests := getBulkServer(t, 10*time.Millisecond)
+		feedIDs := make([]podcast.ID, 100)
+		for i := range feedIDs {
+			feedIDs[i] = podcast.ID(i + 1)
+		}
+		for range client.StreamPodcastsByFeedIDs(context.Background(), feedIDs, &BulkParams{Concurrency: 2}) {
+			break
+		}
+		if requests.Load() > 4 {
+			t.Errorf("expected fetching to stop, got %d requests", requests.Load())
+		}
+	})
+}
+
+func TestBulkCancellation(t *testing.T) {
+	client, _, requests := getBulkServer(t, 20*time.Millisecond)
+	feedIDs := make([]podcast.ID, 50)
+	for i := range feedIDs {
+		feedIDs[i] = podcast.ID(i + 1)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	results := client.GetPodcastsByFeedIDs(ctx, feedIDs, &BulkParams{Concurrency: 2})
+	cancelled := 0
+	for _, result := range results {
+		if errors.Is(result.Err, context.DeadlineExceeded) {
+			cancelled++
+		}
+	}
+	if cancelled == 0 || requests.Load() >= int32(len(feedIDs)) {
+		t.Errorf("expected the remaining IDs to fail with the context's error, got %d cancelled after %d requests", cancelled, requests.Load())
+	}
+	if len(results) != len(feedIDs) {
+		t.Errorf("expected a result for every ID, got %d", len(results))
+	}
+}
+
+func TestGetPodcastsByITunesIDs(t *testing.T) {
+	client, _, _ := getBulkServer(t, 0)
+	results := client.GetPodcastsByITunesIDs(context.Background(), []podcast.ITunesID{"11", "12"}, nil)
+	for i, expected := range []podcast.ID{11, 12} {
+		if results[i].Err != nil || results[i].Value.ID != expected {
+			t.Errorf("expected podcast %d, got %+v", expected, results[i])
+		}
+	}
+	for range client.StreamPodcastsByITunesIDs(context.Background(), []podcast.ITunesID{"11"}, nil) {
+	}
+}
+
+func TestGetEpisodesByIDs(t *testing.T) {
+	client, _, _ := getBulkServer(t, 0)
+	results := client.GetEpisodesByIDs(context.Background(), []episode.ID{21, 22}, nil)
+	for i, expected := range []episode.ID{21, 22} {
+		if results[i].Err != nil || results[i].Value.ID != expected {
+			t.Errorf("expected episode %d, got %+v", expected, results[i])
+		}
+	}
+	for result := range client.StreamEpisodesByIDs(context.Background(), []episode.ID{21}, nil) {
+		if result.Err != nil || result.Value.ID != 21 {
+			t.Errorf("expected episode 21, got %+v", result)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package podcastindex
+
+import (
+	"context"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/internal"
+)
+
+// Cache stores raw response bodies from the PodcastIndex API, so repeated requests need not be sent;
+// set it with NewClientOptions.Cache. See NewMemoryCache and NewFileCache for the implementations provided.
+//
+// Keys are the URL and canonical (sorted) query parameters of a request, e.g.
+// "https://api.podcastindex.org/api/1.0/podcasts/byfeedid?id=75075"; they never contain credentials. Implementations must be safe for concurrent use.
+type Cache = internal.Cache
+
+// CacheMode controls how a single request uses the Cache; set it with WithCacheMode.
+type CacheMode = internal.CacheMode
+
+const (
+	// CacheDefault serves the request from the cache if it can, and caches the response otherwise.
+	CacheDefault = internal.CacheDefault
+	// CacheBypass neither serves the request from the cache, nor caches the response.
+	CacheBypass = internal.CacheBypass
+	// CacheRefresh always sends the request, and caches the response, replacing any cached response.
+	CacheRefresh = internal.CacheRefresh
+)
+
+// WithCacheMode returns a copy of ctx which makes requests use the cache according to mode, e.g.
+//
+//	podcast, err := client.GetPodcastByFeedID(podcastindex.WithCacheMode(ctx, podcastindex.CacheRefresh), feedID)
+func WithCacheMode(ctx context.Context, mode CacheMode) context.Context {
+	return internal.WithCacheMode(ctx, mode)
+}
+
+// CacheTTLs configures how long the responses of each endpoint are cached for.
+//
+// Responses of endpoints which add or notify the index of feeds, e.g. AddPodcastByFeedURL, are never cached.
+type CacheTTLs struct {
+	// Default is how long responses are cached for endpoints not in Endpoints; zero means they are not cached.
+	Default time.Duration
+	// Endpoints maps an endpoint, e.g. "/categories/list", to how long its responses are cached for;
+	// zero means they are not cached.
+	Endpoints map[string]time.Duration
+}
+
+// uncachedEndpoints are the endpoints which change the index, and whose responses are never cached.
+var uncachedEndpoints = map[string]bool{
+	"/add/byfeedurl":  true,
+	"/add/byitunesid": true,
+	"/hub/pubnotify":  true,
+}
+
+// DefaultCacheTTLs returns the CacheTTLs used when a Cache is set without CacheTTLs: responses are cached for
+// 5 minutes, categories for a day, recent and live results for a minute, and random episodes are not cached.
+func DefaultCacheTTLs() CacheTTLs {
+	return CacheTTLs{
+		Default: 5 * time.Minute,
+		Endpoints: map[string]time.Duration{
+			"/categories/list":                24 * time.Hour,
+			"/episodes/live":                  time.Minute,
+			"/episodes/random":                0,
+			"/recent/data":                    time.Minute,
+			"/recent/episodes":                time.Minute,
+			"/recent/feeds":                   time.Minute,
+			"/recent/newfeeds":                time.Minute,
+			"/recent/newvaluefeeds":           time.Minute,
+			"/recent/soundbites":              time.Minute,
+			"/podcasts/trending":              time.Minute,
+			"/stats/current":                  time.Minute,
+			"/static/stats/daily_counts.json": time.Hour,
+		},
+	}
+}
+
+// ttl returns how long the responses of endpoint are cached for.
+func (ttls CacheTTLs) ttl(endpoint string) time.Duration {
+	if uncachedEndpoints[endpoint] {
+		return 0
+	}
+	if ttl, ok := ttls.Endpoints[endpoint]; ok {
+		return ttl
+	}
+	return ttls.Default
+}
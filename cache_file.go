@@ -0,0 +1,87 @@
+package podcastindex
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// FileCache is a Cache storing each response as a file in a directory, so that cached responses
+// survive restarts and can be shared between processes.
+//
+// Each file is named after the SHA-256 hash of its key, and holds the expiry time as unix nanoseconds on its first
+// line, followed by the response body. Expired files are removed when next read.
+type FileCache struct {
+	dir string
+	// now returns the current time; defaults to time.Now, and is overridden in tests.
+	now func() time.Time
+}
+
+var _ Cache = &FileCache{}
+
+// NewFileCache creates a FileCache storing responses in dir, creating it if it does not exist.
+//
+// Returns: the FileCache, or an error if dir cannot be created
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return &FileCache{dir: dir, now: time.Now}, nil
+}
+
+// path returns the path of the file key is cached in.
+func (cache *FileCache) path(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(cache.dir, hex.EncodeToString(hash[:]))
+}
+
+// Get returns the response body cached for key, and whether one was found which has not expired.
+// Files which cannot be read or parsed are treated as not found.
+func (cache *FileCache) Get(_ context.Context, key string) ([]byte, bool) {
+	path := cache.path(key)
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	expiry, value, found := bytes.Cut(contents, []byte("\n"))
+	if !found {
+		return nil, false
+	}
+	expires, err := strconv.ParseInt(string(expiry), 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	if !cache.now().Before(time.Unix(0, expires)) {
+		_ = os.Remove(path)
+		return nil, false
+	}
+	return value, true
+}
+
+// Set caches the response body for key, to expire after ttl.
+//
+// The file is written to a temporary file and renamed into place, so concurrent readers never see a partial response.
+// Failures to write are ignored, leaving the response uncached.
+func (cache *FileCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) {
+	file, err := os.CreateTemp(cache.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	expires := strconv.FormatInt(cache.now().Add(ttl).UnixNano(), 10)
+	_, err = file.Write(append([]byte(expires+"\n"), value...))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), cache.path(key))
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+	}
+}
@@ -0,0 +1,64 @@
+package podcastindex
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileCache(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "cache")
+	cache, err := NewFileCache(dir)
+	if err != nil {
+		t.Fatalf("failed to create file cache: %v", err)
+	}
+	clock := time.Unix(1700000000, 0)
+	cache.now = func() time.Time { return clock }
+
+	t.Run("returns what was set", func(t *testing.T) {
+		cache.Set(ctx, "/podcasts/byfeedid?id=75075", []byte(`{"status": "true"}`), time.Minute)
+		if value, ok := cache.Get(ctx, "/podcasts/byfeedid?id=75075"); !ok || string(value) != `{"status": "true"}` {
+			t.Errorf("expected to get the cached response, got %q, %v", value, ok)
+		}
+		if _, ok := cache.Get(ctx, "/podcasts/byfeedid?id=1"); ok {
+			t.Errorf("expected a missing key not to be found")
+		}
+	})
+	t.Run("survives being reopened", func(t *testing.T) {
+		reopened, err := NewFileCache(dir)
+		if err != nil {
+			t.Fatalf("failed to reopen file cache: %v", err)
+		}
+		reopened.now = cache.now
+		if _, ok := reopened.Get(ctx, "/podcasts/byfeedid?id=75075"); !ok {
+			t.Errorf("expected the cached response to be found by another FileCache")
+		}
+	})
+	t.Run("ignores corrupt files", func(t *testing.T) {
+		if err := os.WriteFile(cache.path("corrupt"), []byte("not an expiry"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := cache.Get(ctx, "corrupt"); ok {
+			t.Errorf("expected a corrupt file not to be found")
+		}
+	})
+	t.Run("expires and removes entries", func(t *testing.T) {
+		clock = clock.Add(time.Minute)
+		if _, ok := cache.Get(ctx, "/podcasts/byfeedid?id=75075"); ok {
+			t.Errorf("expected the response to have expired")
+		}
+		if _, err := os.Stat(cache.path("/podcasts/byfeedid?id=75075")); !os.IsNotExist(err) {
+			t.Errorf("expected the expired file to be removed, got %v", err)
+		}
+	})
+	t.Run("fails when the directory cannot be created", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "file")
+		_ = os.WriteFile(file, nil, 0o644)
+		if _, err := NewFileCache(filepath.Join(file, "cache")); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package podcastindex
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryCache is an in-memory Cache, evicting the least recently used responses once it holds its maximum.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	// entries holds the *memoryCacheEntry values, most recently used first.
+	entries *list.List
+	byKey   map[string]*list.Element
+	// now returns the current time; defaults to time.Now, and is overridden in tests.
+	now func() time.Time
+}
+
+type memoryCacheEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+var _ Cache = &MemoryCache{}
+
+// NewMemoryCache creates a MemoryCache holding at most maxEntries responses; zero or less means no maximum.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		entries:    list.New(),
+		byKey:      map[string]*list.Element{},
+		now:        time.Now,
+	}
+}
+
+// Get returns the response body cached for key, and whether one was found which has not expired.
+func (cache *MemoryCache) Get(_ context.Context, key string) ([]byte, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	element, ok := cache.byKey[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*memoryCacheEntry)
+	if !cache.now().Before(entry.expires) {
+		cache.remove(element)
+		return nil, false
+	}
+	cache.entries.MoveToFront(element)
+	return entry.value, true
+}
+
+// Set caches the response body for key, to expire after ttl, evicting the least recently used response if full.
+func (cache *MemoryCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	entry := &memoryCacheEntry{key: key, value: value, expires: cache.now().Add(ttl)}
+	if element, ok := cache.byKey[key]; ok {
+		element.Value = entry
+		cache.entries.MoveToFront(element)
+		return
+	}
+	cache.byKey[key] = cache.entries.PushFront(entry)
+	if cache.maxEntries > 0 && cache.entries.Len() > cache.maxEntries {
+		cache.remove(cache.entries.Back())
+	}
+}
+
+// Len returns the number of responses held, including any which have expired but not yet been evicted.
+func (cache *MemoryCache) Len() int {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	return cache.entries.Len()
+}
+
+// remove removes element from the cache; the caller must hold mu.
+func (cache *MemoryCache) remove(element *list.Element) {
+	cache.entries.Remove(element)
+	delete(cache.byKey, element.Value.(*memoryCacheEntry).key)
+}
@@ -0,0 +1,53 @@
+package podcastindex
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache(t *testing.T) {
+	ctx := context.Background()
+	clock := time.Unix(1700000000, 0)
+	cache := NewMemoryCache(2)
+	cache.now = func() time.Time { return clock }
+
+	t.Run("returns what was set", func(t *testing.T) {
+		cache.Set(ctx, "a", []byte("1"), time.Minute)
+		if value, ok := cache.Get(ctx, "a"); !ok || string(value) != "1" {
+			t.Errorf("expected to get 1, got %q, %v", value, ok)
+		}
+		if _, ok := cache.Get(ctx, "missing"); ok {
+			t.Errorf("expected a missing key not to be found")
+		}
+	})
+	t.Run("evicts the least recently used entry", func(t *testing.T) {
+		cache.Set(ctx, "b", []byte("2"), time.Minute)
+		cache.Get(ctx, "a")
+		cache.Set(ctx, "c", []byte("3"), time.Minute)
+		if _, ok := cache.Get(ctx, "b"); ok {
+			t.Errorf("expected b to have been evicted")
+		}
+		if _, ok := cache.Get(ctx, "a"); !ok {
+			t.Errorf("expected a to have been kept, as it was used more recently")
+		}
+		if cache.Len() != 2 {
+			t.Errorf("expected 2 entries, got %d", cache.Len())
+		}
+	})
+	t.Run("replaces an existing entry", func(t *testing.T) {
+		cache.Set(ctx, "a", []byte("one"), time.Minute)
+		if value, _ := cache.Get(ctx, "a"); string(value) != "one" || cache.Len() != 2 {
+			t.Errorf("expected a to be replaced, got %q with %d entries", value, cache.Len())
+		}
+	})
+	t.Run("expires entries", func(t *testing.T) {
+		clock = clock.Add(time.Minute)
+		if _, ok := cache.Get(ctx, "a"); ok {
+			t.Errorf("expected a to have expired")
+		}
+		if cache.Len() != 1 {
+			t.Errorf("expected the expired entry to be removed, got %d entries", cache.Len())
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package podcastindex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+func TestClientCache(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		_, _ = w.Write([]byte(`{"status": "true", "feeds": [{"id": 1, "name": "Arts"}], "feed": {"id": 75075}, "feedId": 75075}`))
+	}))
+	t.Cleanup(server.Close)
+	serverURL, _ := url.Parse(server.URL)
+
+	cache := NewMemoryCache(100)
+	client := NewClient(NewClientOptions{
+		UserAgent: "podcastindex-go/testrunner",
+		BaseURL:   serverURL,
+		Cache:     cache,
+	})
+	ctx := context.Background()
+
+	t.Run("repeated calls are served from the cache", func(t *testing.T) {
+		for range 3 {
+			if _, err := client.Categories(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if requests["/categories/list"] != 1 {
+			t.Errorf("expected 1 request, got %d", requests["/categories/list"])
+		}
+		if _, ok := cache.Get(ctx, server.URL+"/categories/list"); !ok {
+			t.Errorf("expected the response to be cached under its URL and query")
+		}
+	})
+	t.Run("refresh sends the request again", func(t *testing.T) {
+		if _, err := client.Categories(WithCacheMode(ctx, CacheRefresh)); err != nil {
+			t.Fatal(err)
+		}
+		if requests["/categories/list"] != 2 {
+			t.Errorf("expected 2 requests, got %d", requests["/categories/list"])
+		}
+	})
+	t.Run("endpoints which change the index are never cached", func(t *testing.T) {
+		for range 2 {
+			if _, err := client.AddPodcastByITunesID(ctx, podcast.ITunesID("1")); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if requests["/add/byitunesid"] != 2 {
+			t.Errorf("expected 2 requests, got %d", requests["/add/byitunesid"])
+		}
+	})
+}
+
+func TestCacheTTLs(t *testing.T) {
+	ttls := CacheTTLs{
+		Default:   time.Minute,
+		Endpoints: map[string]time.Duration{"/categories/list": time.Hour, "/episodes/random": 0},
+	}
+	expected := map[string]time.Duration{
+		"/categories/list":   time.Hour,
+		"/episodes/random":   0,
+		"/podcasts/byfeedid": time.Minute,
+		"/add/byfeedurl":     0,
+		"/hub/pubnotify":     0,
+	}
+	for endpoint, ttl := range expected {
+		if actual := ttls.ttl(endpoint); actual != ttl {
+			t.Errorf("expected %s to be cached for %s, got %s", endpoint, ttl, actual)
+		}
+	}
+	if DefaultCacheTTLs().ttl("/episodes/random") != 0 {
+		t.Errorf("expected random episodes not to be cached by default")
+	}
+}
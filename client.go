@@ -2,8 +2,10 @@ package podcastindex
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/jjgmckenzie/podcastindex/internal"
 )
@@ -22,6 +24,14 @@ type api interface {
 	//
 	// Returns: error if the request fails, or the response body is not valid JSON
 	Get(ctx context.Context, path string, params url.Values, result any) error
+	// Post makes a POST request to the PodcastIndex API, sending body encoded as JSON
+	//
+	// Returns: error if the request fails, or the response body is not valid JSON
+	Post(ctx context.Context, path string, params url.Values, body any, result any) error
+	// GetRoot makes a GET request to a path relative to the root of the PodcastIndex API host, rather than BaseURL
+	//
+	// Returns: error if the request fails, or the response body is not valid JSON
+	GetRoot(ctx context.Context, path string, params url.Values, result any) error
 	// GetRawJSON makes a GET request to the PodcastIndex API and returns the raw JSON response;
 	//
 	// This is useful for debugging and testing
@@ -32,6 +42,10 @@ type api interface {
 // Client is the client for the PodcastIndex Library
 type Client struct {
 	api api
+	// logger receives the client's diagnostics.
+	logger *slog.Logger
+	// warnings (Optional) receives the warnings raised by the client.
+	warnings chan<- Warning
 }
 
 // NewClientOptions is the options for the NewClient function
@@ -50,6 +64,34 @@ type Client struct {
 //
 // HTTPClient (Optional) is the HTTP client to use for all api requests; defaults to http.DefaultClient
 // Useful for custom timeouts, proxies, or TLS configuration
+//
+// RetryPolicy (Optional) is the policy used to retry failed requests, e.g. DefaultRetryPolicy(); defaults to no retries
+//
+// RateLimiter (Optional) limits the rate of requests, see NewRateLimiter; defaults to no limit.
+// Share one RateLimiter between all Clients using the same API key.
+//
+// Cache (Optional) caches the responses of requests, see NewMemoryCache and NewFileCache; defaults to no cache.
+//
+// CacheTTLs (Optional) configures how long the responses of each endpoint are cached for; defaults to DefaultCacheTTLs()
+//
+// CoalesceRequests (Optional) makes identical concurrent calls, e.g. GetPodcastByFeedID for the same feed, share a
+// single request, see CoalesceMiddleware; defaults to false
+//
+// Middlewares (Optional) wrap every request, the first outermost. They run outside of the Cache, CoalesceRequests,
+// RetryPolicy and RateLimiter; to order those differently, leave them unset and use CacheMiddleware,
+// CoalesceMiddleware, RetryMiddleware and RateLimitMiddleware in Middlewares instead.
+//
+// Logger (Optional) receives the library's diagnostics, e.g. warnings, retries and, at debug level, each request,
+// with the method and endpoint of the request as attributes; defaults to discarding them.
+//
+// Warnings (Optional) receives each Warning raised, e.g. when a parameter is corrected to work around an API quirk.
+// Warnings are never blocked on: if the channel is full, they are only logged.
+//
+// Credentials (Optional) provides the API key and secret each request is signed with, in place of APIKey and
+// APISecret, e.g. EnvCredentials, FileCredentials or RotatingCredentials
+//
+// Clock (Optional) tells the time requests are signed at; defaults to the system clock.
+// Clock skew detected from the API's responses is corrected automatically, see Clock.
 type NewClientOptions struct {
 	// UserAgent: Please identify the system/product you are using to make this request.
 	// Example: SuperPodcastPlayer/1.3
@@ -63,6 +105,27 @@ type NewClientOptions struct {
 	BaseURL *url.URL
 	// HTTPClient (Optional) is the HTTP client to use for all api requests; defaults to http.DefaultClient
 	HTTPClient *http.Client
+	// RetryPolicy (Optional) is the policy used to retry failed requests, e.g. DefaultRetryPolicy(); defaults to no retries
+	RetryPolicy *RetryPolicy
+	// RateLimiter (Optional) limits the rate of requests, see NewRateLimiter; defaults to no limit.
+	// Share one RateLimiter between all Clients using the same API key.
+	RateLimiter *RateLimiter
+	// Cache (Optional) caches the responses of requests, see NewMemoryCache and NewFileCache; defaults to no cache.
+	Cache Cache
+	// CacheTTLs (Optional) configures how long the responses of each endpoint are cached for; defaults to DefaultCacheTTLs()
+	CacheTTLs *CacheTTLs
+	// CoalesceRequests (Optional) makes identical concurrent calls share a single request; defaults to false
+	CoalesceRequests bool
+	// Middlewares (Optional) wrap every request, the first outermost, outside of the built-in features above.
+	Middlewares []Middleware
+	// Logger (Optional) receives the library's diagnostics, with request-scoped attributes; defaults to discarding them.
+	Logger *slog.Logger
+	// Warnings (Optional) receives each Warning raised; warnings are only logged if the channel is full.
+	Warnings chan<- Warning
+	// Credentials (Optional) provides the API key and secret each request is signed with, in place of APIKey and APISecret
+	Credentials CredentialsProvider
+	// Clock (Optional) tells the time requests are signed at; defaults to the system clock.
+	Clock Clock
 }
 
 // NewClient creates a new Client, and takes a NewClientOptions struct as an argument
@@ -75,13 +138,32 @@ func NewClient(options NewClientOptions) *Client {
 	if options.HTTPClient == nil {
 		options.HTTPClient = http.DefaultClient
 	}
+	if options.Cache != nil && options.CacheTTLs == nil {
+		ttls := DefaultCacheTTLs()
+		options.CacheTTLs = &ttls
+	}
+	var cacheTTL func(endpoint string) time.Duration
+	if options.CacheTTLs != nil {
+		cacheTTL = options.CacheTTLs.ttl
+	}
 	return &Client{
 		api: &internal.PodcastIndexAPI{
-			BaseURL:    options.BaseURL,
-			APIKey:     options.APIKey,
-			APISecret:  options.APISecret,
-			UserAgent:  options.UserAgent,
-			HTTPClient: options.HTTPClient,
+			BaseURL:          options.BaseURL,
+			APIKey:           options.APIKey,
+			APISecret:        options.APISecret,
+			UserAgent:        options.UserAgent,
+			HTTPClient:       options.HTTPClient,
+			RetryPolicy:      options.RetryPolicy,
+			RateLimiter:      options.RateLimiter,
+			Cache:            options.Cache,
+			CacheTTL:         cacheTTL,
+			Middlewares:      options.Middlewares,
+			CoalesceRequests: options.CoalesceRequests,
+			Logger:           options.Logger,
+			Clock:            options.Clock,
+			Credentials:      options.Credentials,
 		},
+		logger:   options.Logger,
+		warnings: options.Warnings,
 	}
 }
@@ -0,0 +1,18 @@
+package podcastindex
+
+import "github.com/jjgmckenzie/podcastindex/internal"
+
+// Clock tells the time requests are signed at; set it with NewClientOptions.Clock, e.g. to sign requests with a
+// fixed time in tests.
+//
+// Whatever the Clock, if the API rejects a request's credentials, and the Date header of its response shows the
+// request was signed more than MaxClockSkew from the API's clock, the request is re-signed with the API's time and
+// sent once more. The correction is logged, and kept for the Client's later requests.
+type Clock = internal.Clock
+
+// ClockFunc is a Clock calling a function, e.g. time.Now or a fake clock in tests.
+type ClockFunc = internal.ClockFunc
+
+// MaxClockSkew is how far the time a request was signed at may be from the API's clock before an authentication
+// failure is put down to clock skew and corrected.
+const MaxClockSkew = internal.MaxClockSkew
@@ -0,0 +1,31 @@
+package podcastindex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestClientClock(t *testing.T) {
+	var authDates []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authDates = append(authDates, r.Header.Get("X-Auth-Date"))
+		_, _ = w.Write([]byte(`{"status": "true", "feeds": []}`))
+	}))
+	t.Cleanup(server.Close)
+	serverURL, _ := url.Parse(server.URL)
+
+	client := NewClient(NewClientOptions{
+		BaseURL: serverURL,
+		Clock:   ClockFunc(func() time.Time { return time.Unix(1700000000, 0) }),
+	})
+	if _, err := client.Categories(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(authDates) != 1 || authDates[0] != "1700000000" {
+		t.Errorf("expected the request to be signed with the clock's time, got %v", authDates)
+	}
+}
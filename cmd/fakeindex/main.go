@@ -0,0 +1,46 @@
+// Command fakeindex serves an in-memory fake of the PodcastIndex API, for testing PodcastIndex clients without
+// network access or credentials; see the fakeindex package.
+//
+// Usage:
+//
+//	fakeindex [-addr :8080] [-key KEY] [-secret SECRET] [-fixture file.json]... [-fault '{"statusCode": 429}']...
+//
+// The API is served under /api/1.0/, and requests must be signed with the key and secret. Fixtures and faults can
+// also be added while the server is running, through the admin endpoints under /fakeindex/.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/jjgmckenzie/podcastindex/fakeindex"
+)
+
+func main() {
+	server := fakeindex.NewServer()
+	addr := flag.String("addr", ":8080", "the address to listen on")
+	key := flag.String("key", fakeindex.TestAPIKey, "the API key requests are signed with")
+	secret := flag.String("secret", fakeindex.TestAPISecret, "the API secret requests are signed with")
+	flag.Func("fixture", "a JSON fixture file to load; may be repeated", server.LoadFixtureFile)
+	flag.Func("fault", "a fault to inject, as JSON, e.g. {\"endpoint\": \"/search/byterm\", \"latency\": \"1s\"}; may be repeated", func(value string) error {
+		var fault fakeindex.Fault
+		if err := json.Unmarshal([]byte(value), &fault); err != nil {
+			return fmt.Errorf("failed to decode fault: %w", err)
+		}
+		server.Inject(fault)
+		return nil
+	})
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags]\n\nServes an in-memory fake of the PodcastIndex API.\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	server.AddCredentials(*key, *secret)
+
+	log.Printf("fakeindex: serving the PodcastIndex API at http://%s%s/", *addr, fakeindex.BasePath)
+	log.Fatal(http.ListenAndServe(*addr, server))
+}
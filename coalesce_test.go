@@ -0,0 +1,53 @@
+package podcastindex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClientCoalesceRequests(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		// hold the request long enough for every caller to join it
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"status": "true", "feed": {"id": 75075, "title": "Batman University"}}`))
+	}))
+	t.Cleanup(server.Close)
+	serverURL, _ := url.Parse(server.URL)
+	client := NewClient(NewClientOptions{
+		UserAgent:        "podcastindex-go/testrunner",
+		BaseURL:          serverURL,
+		CoalesceRequests: true,
+	})
+
+	podcasts := make([]*Podcast, 5)
+	var wg sync.WaitGroup
+	for i := range podcasts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			podcasts[i], _ = client.GetPodcastByFeedID(context.Background(), testValidFeedID)
+		}()
+	}
+	wg.Wait()
+
+	if requests.Load() != 1 {
+		t.Errorf("expected the calls to share 1 request, got %d", requests.Load())
+	}
+	if podcasts[0] == nil {
+		t.Fatal("expected the calls to succeed")
+	}
+	podcasts[0].Title = "changed"
+	for _, podcast := range podcasts[1:] {
+		if podcast == nil || podcast == podcasts[0] || podcast.Title != "Batman University" {
+			t.Errorf("expected each caller to get its own copy of the podcast")
+		}
+	}
+}
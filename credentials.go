@@ -0,0 +1,171 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/jjgmckenzie/podcastindex/internal"
+)
+
+// Credentials are the API key and secret requests to the PodcastIndex API are signed with.
+type Credentials = internal.Credentials
+
+// CredentialsProvider provides the Credentials to sign a request with; set it with NewClientOptions.Credentials.
+//
+// It is consulted each time a request is signed, including retries, so the credentials it provides may change,
+// e.g. when they are rotated. Implementations must be safe for concurrent use. See EnvCredentials,
+// FileCredentials and RotatingCredentials for the implementations provided.
+type CredentialsProvider = internal.CredentialsProvider
+
+// ErrInvalidCredentials is wrapped by the error returned when credentials, or a user agent, are missing or invalid.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+const (
+	// EnvAPIKey is the environment variable the API key is read from by default.
+	EnvAPIKey = "PODCASTINDEX_API_KEY"
+	// EnvAPISecret is the environment variable the API secret is read from by default.
+	EnvAPISecret = "PODCASTINDEX_API_SECRET"
+	// EnvUserAgent is the environment variable the user agent is read from by NewClientFromEnv,
+	// if NewClientOptions.UserAgent is not set.
+	EnvUserAgent = "PODCASTINDEX_USER_AGENT"
+)
+
+// validateCredentials checks credentials have a key and secret, without surrounding whitespace.
+//
+// Returns: an error wrapping ErrInvalidCredentials describing each problem found, or nil
+func validateCredentials(credentials Credentials) error {
+	var errs []error
+	for _, field := range []struct{ name, value string }{
+		{"API key", credentials.APIKey},
+		{"API secret", credentials.APISecret},
+	} {
+		switch {
+		case field.value == "":
+			errs = append(errs, fmt.Errorf("%w: %s is empty", ErrInvalidCredentials, field.name))
+		case strings.TrimSpace(field.value) != field.value:
+			errs = append(errs, fmt.Errorf("%w: %s has leading or trailing whitespace", ErrInvalidCredentials, field.name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// EnvCredentials is a CredentialsProvider reading the API key and secret from environment variables
+// each time a request is signed.
+type EnvCredentials struct {
+	// KeyVariable (Optional) is the environment variable holding the API key; defaults to EnvAPIKey
+	KeyVariable string
+	// SecretVariable (Optional) is the environment variable holding the API secret; defaults to EnvAPISecret
+	SecretVariable string
+}
+
+// Credentials returns the API key and secret held by the environment variables.
+//
+// Returns: an error wrapping ErrInvalidCredentials if either is unset or invalid
+func (env EnvCredentials) Credentials(context.Context) (Credentials, error) {
+	keyVariable, secretVariable := env.KeyVariable, env.SecretVariable
+	if keyVariable == "" {
+		keyVariable = EnvAPIKey
+	}
+	if secretVariable == "" {
+		secretVariable = EnvAPISecret
+	}
+	credentials := Credentials{APIKey: os.Getenv(keyVariable), APISecret: os.Getenv(secretVariable)}
+	if err := validateCredentials(credentials); err != nil {
+		return Credentials{}, fmt.Errorf("from environment variables %s and %s: %w", keyVariable, secretVariable, err)
+	}
+	return credentials, nil
+}
+
+// FileCredentials is a CredentialsProvider reading the API key and secret from files each time a request is signed,
+// e.g. a Kubernetes secret mounted as a volume, so that updates to the secret are picked up without a restart.
+// Leading and trailing whitespace, such as a trailing newline, is trimmed from the contents of the files.
+type FileCredentials struct {
+	// KeyFile is the path of the file holding the API key, e.g. /var/run/secrets/podcastindex/api-key
+	KeyFile string
+	// SecretFile is the path of the file holding the API secret, e.g. /var/run/secrets/podcastindex/api-secret
+	SecretFile string
+}
+
+// Credentials returns the API key and secret held by the files.
+//
+// Returns: an error if either file cannot be read, or one wrapping ErrInvalidCredentials if either is empty
+func (files FileCredentials) Credentials(context.Context) (Credentials, error) {
+	key, err := os.ReadFile(files.KeyFile)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to read API key: %w", err)
+	}
+	secret, err := os.ReadFile(files.SecretFile)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to read API secret: %w", err)
+	}
+	credentials := Credentials{APIKey: strings.TrimSpace(string(key)), APISecret: strings.TrimSpace(string(secret))}
+	if err := validateCredentials(credentials); err != nil {
+		return Credentials{}, fmt.Errorf("from files %s and %s: %w", files.KeyFile, files.SecretFile, err)
+	}
+	return credentials, nil
+}
+
+// RotatingCredentials is a CredentialsProvider holding credentials in memory, which can be replaced with Rotate
+// while requests are being made, e.g. by a secret manager's rotation callback.
+type RotatingCredentials struct {
+	mu          sync.RWMutex
+	credentials Credentials
+}
+
+// NewRotatingCredentials creates a RotatingCredentials providing credentials until they are rotated.
+//
+// Returns: an error wrapping ErrInvalidCredentials if credentials are invalid
+func NewRotatingCredentials(credentials Credentials) (*RotatingCredentials, error) {
+	if err := validateCredentials(credentials); err != nil {
+		return nil, err
+	}
+	return &RotatingCredentials{credentials: credentials}, nil
+}
+
+// Rotate replaces the credentials provided, for every request signed after it returns.
+//
+// Returns: an error wrapping ErrInvalidCredentials, leaving the credentials unchanged, if credentials are invalid
+func (rotating *RotatingCredentials) Rotate(credentials Credentials) error {
+	if err := validateCredentials(credentials); err != nil {
+		return err
+	}
+	rotating.mu.Lock()
+	defer rotating.mu.Unlock()
+	rotating.credentials = credentials
+	return nil
+}
+
+// Credentials returns the current credentials.
+func (rotating *RotatingCredentials) Credentials(context.Context) (Credentials, error) {
+	rotating.mu.RLock()
+	defer rotating.mu.RUnlock()
+	return rotating.credentials, nil
+}
+
+// NewClientFromEnv creates a new Client with the API key and secret held by the EnvAPIKey and EnvAPISecret
+// environment variables, and the user agent held by EnvUserAgent unless options.UserAgent is set.
+// The other options are used as in NewClient; options.APIKey, APISecret and Credentials are ignored.
+//
+// The key, secret and user agent are validated before any request is made.
+//
+// Returns: A new PodcastIndex API Client, or an error wrapping ErrInvalidCredentials if any are missing or invalid
+func NewClientFromEnv(options NewClientOptions) (*Client, error) {
+	credentials, err := EnvCredentials{}.Credentials(context.Background())
+	if options.UserAgent == "" {
+		options.UserAgent = os.Getenv(EnvUserAgent)
+	}
+	if strings.TrimSpace(options.UserAgent) == "" {
+		err = errors.Join(err, fmt.Errorf("%w: user agent is empty, set %s or NewClientOptions.UserAgent", ErrInvalidCredentials, EnvUserAgent))
+	}
+	if err != nil {
+		return nil, err
+	}
+	options.APIKey = credentials.APIKey
+	options.APISecret = credentials.APISecret
+	options.Credentials = nil
+	return NewClient(options), nil
+}
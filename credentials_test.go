@@ -0,0 +1,143 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestEnvCredentials(t *testing.T) {
+	t.Run("reads the default variables", func(t *testing.T) {
+		t.Setenv(EnvAPIKey, "KEY")
+		t.Setenv(EnvAPISecret, "SECRET")
+		credentials, err := EnvCredentials{}.Credentials(context.Background())
+		if err != nil || credentials.APIKey != "KEY" || credentials.APISecret != "SECRET" {
+			t.Errorf("expected KEY and SECRET, got %+v, %v", credentials, err)
+		}
+	})
+	t.Run("reads custom variables", func(t *testing.T) {
+		t.Setenv("MY_KEY", "KEY2")
+		t.Setenv("MY_SECRET", "SECRET2")
+		credentials, err := EnvCredentials{KeyVariable: "MY_KEY", SecretVariable: "MY_SECRET"}.Credentials(context.Background())
+		if err != nil || credentials.APIKey != "KEY2" || credentials.APISecret != "SECRET2" {
+			t.Errorf("expected KEY2 and SECRET2, got %+v, %v", credentials, err)
+		}
+	})
+	t.Run("fails when unset", func(t *testing.T) {
+		t.Setenv(EnvAPIKey, "")
+		t.Setenv(EnvAPISecret, "SECRET")
+		if _, err := (EnvCredentials{}).Credentials(context.Background()); !errors.Is(err, ErrInvalidCredentials) {
+			t.Errorf("expected ErrInvalidCredentials, got %v", err)
+		}
+	})
+}
+
+func TestFileCredentials(t *testing.T) {
+	dir := t.TempDir()
+	files := FileCredentials{KeyFile: filepath.Join(dir, "api-key"), SecretFile: filepath.Join(dir, "api-secret")}
+	write := func(key, secret string) {
+		_ = os.WriteFile(files.KeyFile, []byte(key), 0o600)
+		_ = os.WriteFile(files.SecretFile, []byte(secret), 0o600)
+	}
+
+	t.Run("fails when the files are missing", func(t *testing.T) {
+		if _, err := files.Credentials(context.Background()); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("expected a missing file error, got %v", err)
+		}
+	})
+	t.Run("reads and trims the files", func(t *testing.T) {
+		write("KEY\n", "SECRET\n")
+		credentials, err := files.Credentials(context.Background())
+		if err != nil || credentials.APIKey != "KEY" || credentials.APISecret != "SECRET" {
+			t.Errorf("expected KEY and SECRET, got %+v, %v", credentials, err)
+		}
+	})
+	t.Run("picks up updated files", func(t *testing.T) {
+		write("KEY2", "SECRET2")
+		credentials, _ := files.Credentials(context.Background())
+		if credentials.APIKey != "KEY2" {
+			t.Errorf("expected the updated key, got %q", credentials.APIKey)
+		}
+	})
+	t.Run("fails when a file is empty", func(t *testing.T) {
+		write("KEY", "")
+		if _, err := files.Credentials(context.Background()); !errors.Is(err, ErrInvalidCredentials) {
+			t.Errorf("expected ErrInvalidCredentials, got %v", err)
+		}
+	})
+}
+
+func TestRotatingCredentials(t *testing.T) {
+	if _, err := NewRotatingCredentials(Credentials{APIKey: "KEY"}); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("expected ErrInvalidCredentials for a missing secret, got %v", err)
+	}
+	rotating, err := NewRotatingCredentials(Credentials{APIKey: "KEY", APISecret: "SECRET"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("X-Auth-Key"))
+		_, _ = w.Write([]byte(`{"status": "true", "feeds": []}`))
+	}))
+	t.Cleanup(server.Close)
+	serverURL, _ := url.Parse(server.URL)
+	client := NewClient(NewClientOptions{UserAgent: "podcastindex-go/testrunner", BaseURL: serverURL, Credentials: rotating})
+
+	_, _ = client.Categories(context.Background())
+	if err := rotating.Rotate(Credentials{APIKey: " KEY2", APISecret: "SECRET2"}); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("expected ErrInvalidCredentials for a key with whitespace, got %v", err)
+	}
+	if err := rotating.Rotate(Credentials{APIKey: "KEY2", APISecret: "SECRET2"}); err != nil {
+		t.Fatal(err)
+	}
+	_, _ = client.Categories(context.Background())
+	if len(keys) != 2 || keys[0] != "KEY" || keys[1] != "KEY2" {
+		t.Errorf("expected requests to be signed with KEY, then KEY2, got %v", keys)
+	}
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	t.Run("validates the environment", func(t *testing.T) {
+		t.Setenv(EnvAPIKey, "")
+		t.Setenv(EnvAPISecret, "")
+		t.Setenv(EnvUserAgent, "")
+		client, err := NewClientFromEnv(NewClientOptions{})
+		if client != nil || !errors.Is(err, ErrInvalidCredentials) {
+			t.Fatalf("expected ErrInvalidCredentials, got %v", err)
+		}
+		for _, problem := range []string{"API key is empty", "API secret is empty", "user agent is empty"} {
+			if !strings.Contains(err.Error(), problem) {
+				t.Errorf("expected the error to report %q, got %v", problem, err)
+			}
+		}
+	})
+	t.Run("creates a client", func(t *testing.T) {
+		var headers http.Header
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			headers = r.Header
+			_, _ = w.Write([]byte(`{"status": "true", "feeds": []}`))
+		}))
+		t.Cleanup(server.Close)
+		serverURL, _ := url.Parse(server.URL)
+
+		t.Setenv(EnvAPIKey, "KEY")
+		t.Setenv(EnvAPISecret, "SECRET")
+		t.Setenv(EnvUserAgent, "podcastindex-go/testrunner")
+		client, err := NewClientFromEnv(NewClientOptions{BaseURL: serverURL})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _ = client.Categories(context.Background())
+		if headers.Get("X-Auth-Key") != "KEY" || headers.Get("User-Agent") != "podcastindex-go/testrunner" {
+			t.Errorf("expected the request to use the environment's key and user agent, got %v", headers)
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"reflect"
 	"strconv"
 	"time"
 
@@ -105,6 +106,9 @@ type Episode struct {
 	ContentLink *string
 	// Duration is the duration of the episode in seconds
 	Duration *int
+	// Extra holds the fields of the episode's JSON which this library does not model, e.g. fields added to the API
+	// since, keyed by name. They are marshalled again along with the episode; decode one with ExtraField.
+	Extra map[string]json.RawMessage
 }
 
 // episodeJSON is an intermediary struct used for unmarshalling Episode data,
@@ -149,6 +153,9 @@ type episodeJSON struct {
 	Duration            *int                      `json:"duration,omitempty"`
 }
 
+// episodeJSONFields are the names of the fields of episodeJSON; any other fields are kept in Episode.Extra.
+var episodeJSONFields = internal.JSONFieldNames(reflect.TypeFor[episodeJSON]())
+
 // UnmarshalJSON implements the json.Unmarshaler interface for Episode.
 // It handles the conversion of Unix timestamps to time.Time, URLs and other data conversions.
 func (e *Episode) UnmarshalJSON(data []byte) error {
@@ -156,6 +163,11 @@ func (e *Episode) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &aux); err != nil {
 		return err
 	}
+	extra, err := internal.UnknownFields(data, episodeJSONFields)
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
 
 	// Direct field assignments
 	e.ID = episode.ID(aux.ID)
@@ -220,7 +232,6 @@ func (e *Episode) UnmarshalJSON(data []byte) error {
 	}
 
 	// URL parsing
-	var err error
 	var parsedURL *url.URL
 
 	// Parse Link URL
@@ -373,5 +384,9 @@ func (e *Episode) MarshalJSON() ([]byte, error) {
 		aux.EndTime = &endTime
 	}
 
-	return json.Marshal(aux)
+	encoded, err := json.Marshal(aux)
+	if err != nil {
+		return nil, err
+	}
+	return internal.AppendFields(encoded, e.Extra, episodeJSONFields)
 }
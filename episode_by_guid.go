@@ -0,0 +1,49 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/jjgmckenzie/podcastindex/episode"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// EpisodeGUIDScope identifies the podcast an episode GUID belongs to, for the GetEpisodeByGUID method.
+//
+// As episode GUIDs are only unique within a feed, one of FeedID, FeedURL or PodcastGUID must be set.
+type EpisodeGUIDScope struct {
+	// FeedID is the internal PodcastIndex.org Feed ID of the podcast.
+	FeedID podcast.ID
+	// FeedURL is the feed url of the podcast.
+	FeedURL *url.URL
+	// PodcastGUID is the podcast.GUID of the podcast.
+	PodcastGUID podcast.GUID
+}
+
+// GetEpisodeByGUID returns the episode with the given episode.GUID, within the podcast identified by scope.
+//
+// Of the optional parameters, only FullText applies to a single episode; see GetEpisodesParams for more details.
+func (c *Client) GetEpisodeByGUID(ctx context.Context, guid episode.GUID, scope EpisodeGUIDScope, params *GetEpisodesParams) (*Episode, error) {
+	var response getSingleEpisodeResponse
+	urlParams := url.Values{"guid": {string(guid)}}
+	switch {
+	case scope.FeedID != 0:
+		urlParams.Set("feedid", strconv.Itoa(int(scope.FeedID)))
+	case scope.FeedURL != nil:
+		urlParams.Set("feedurl", scope.FeedURL.String())
+	case scope.PodcastGUID != "":
+		urlParams.Set("podcastguid", string(scope.PodcastGUID))
+	default:
+		return nil, errors.New("GetEpisodeByGUID requires a FeedID, FeedURL or PodcastGUID to scope the episode GUID")
+	}
+	if params != nil && params.FullText {
+		urlParams.Add("fulltext", "")
+	}
+	err := c.api.Get(ctx, "/episodes/byguid", urlParams, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Episode, nil
+}
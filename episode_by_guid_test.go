@@ -0,0 +1,83 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+)
+
+func TestGetEpisodeByGUIDRequest(t *testing.T) {
+	testCases := []struct {
+		name          string
+		scope         EpisodeGUIDScope
+		expectedScope url.Values
+	}{
+		{
+			name:          "scoped by feed ID",
+			scope:         EpisodeGUIDScope{FeedID: testValueFeedID},
+			expectedScope: url.Values{"feedid": {"920666"}},
+		},
+		{
+			name:          "scoped by feed URL",
+			scope:         EpisodeGUIDScope{FeedURL: &testEpisodesFeedURL},
+			expectedScope: url.Values{"feedurl": {testEpisodesFeedURL.String()}},
+		},
+		{
+			name:          "scoped by podcast GUID",
+			scope:         EpisodeGUIDScope{PodcastGUID: testValuePodcastGUID},
+			expectedScope: url.Values{"podcastguid": {string(testValuePodcastGUID)}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			searchServer := GetSearchServer(t)
+			defer searchServer.Server.Close()
+
+			serverURL, _ := url.Parse(searchServer.Server.URL)
+			client := NewClient(NewClientOptions{
+				BaseURL: serverURL,
+			})
+
+			expectedQuery := url.Values{
+				"guid":     {string(testValueEpisodeGUID)},
+				"fulltext": {},
+			}
+			for key, values := range tc.expectedScope {
+				expectedQuery[key] = values
+			}
+
+			_, _ = client.GetEpisodeByGUID(context.Background(), testValueEpisodeGUID, tc.scope, &GetEpisodesParams{FullText: true})
+			if err := searchServer.ExpectPathAndQuery("/episodes/byguid", expectedQuery); err != nil {
+				t.Error(err.Error())
+			}
+		})
+	}
+	t.Run("the client requires a scope", func(t *testing.T) {
+		_, err := unauthenticatedClient().GetEpisodeByGUID(context.Background(), testValueEpisodeGUID, EpisodeGUIDScope{}, nil)
+		if err == nil {
+			t.Errorf("expected an error when the episode GUID is not scoped")
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).GetEpisodeByGUID(context.Background(), testValueEpisodeGUID, EpisodeGUIDScope{FeedID: testValueFeedID}, nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestGetEpisodeByGUIDIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	episodes, err := client.GetEpisodesByFeedID(context.Background(), testValueFeedID, &GetEpisodesParams{Max: 1})
+	if err != nil || len(*episodes) == 0 {
+		t.Fatalf("failed to get an episode to look up: %v", err)
+	}
+	latest := (*episodes)[0]
+	e, err := client.GetEpisodeByGUID(context.Background(), latest.GUID, EpisodeGUIDScope{FeedID: testValueFeedID}, nil)
+	if err != nil {
+		t.Fatalf("GetEpisodeByGUID failed: %v", err)
+	}
+	if e.ID != latest.ID {
+		t.Fatalf("got episode ID %d, want %d", e.ID, latest.ID)
+	}
+}
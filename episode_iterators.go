@@ -0,0 +1,162 @@
+package podcastindex
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/episode"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// maxEpisodesPerPage is the most episodes the API returns per request, used as the page size of episode iterators
+// when no Max is given.
+const maxEpisodesPerPage = 1000
+
+// ErrHistoryTruncated is yielded by AllEpisodesByFeedID when more of a feed's episodes were published at the same time
+// than the API returns in one page. "since" includes episodes published at exactly that time, so pages cannot move
+// past them; iteration stops with this error, leaving the cursor at the last episode yielded.
+var ErrHistoryTruncated = errors.New("episode history truncated by the API's page size")
+
+// feedHistoryStart is the "since" of the first page of a feed's whole history. Without "since", the API returns the
+// newest episodes first, so it is always given to page from the oldest.
+var feedHistoryStart = time.Unix(0, 0)
+
+// EpisodeCursor is the position of an episode iterator, to resume iteration from, e.g. after a restart.
+//
+// Iterators update the cursor they are given as each episode is yielded, so a copy saved at any point, e.g. encoded
+// as JSON, resumes iteration after the last episode yielded.
+type EpisodeCursor struct {
+	// Since is the publish time of the newest episode yielded by AllEpisodesByFeedID.
+	Since time.Time `json:"since,omitzero"`
+	// SeenAtSince are the IDs of the episodes yielded by AllEpisodesByFeedID published at exactly Since,
+	// so they are not yielded again when the API returns them in the next page.
+	SeenAtSince []episode.ID `json:"seenAtSince,omitempty"`
+	// Before is the ID of the oldest episode yielded by AllRecentEpisodes.
+	Before episode.ID `json:"before,omitempty"`
+}
+
+// AllEpisodesByFeedID iterates over the episodes of a feed, oldest first, from cursor onwards, fetching pages with the
+// API's "since" parameter as the iteration proceeds, and updating cursor as each episode is yielded.
+// Given "since", the API returns the episodes published at or after it oldest first, so each page starts from the
+// newest episode of the previous one until a page is not full, and the whole history is walked however many episodes
+// the feed has.
+// Episodes the API returns on more than one page are only yielded once.
+//
+// cursor (Optional) is where to resume from; nil starts from params.Since, or the feed's first episode.
+// Pass the same cursor again later to iterate only over episodes published since.
+// params (Optional): params.Max is the page size, defaulting to 1000, the most the API returns.
+//
+// Iteration stops with ErrHistoryTruncated if more episodes than fit in a page were published at the same time.
+// Iteration stops after any other error, which is yielded with an empty episode.
+func (c *Client) AllEpisodesByFeedID(ctx context.Context, feedID podcast.ID, params *GetEpisodesParams, cursor *EpisodeCursor) iter.Seq2[Episode, error] {
+	return func(yield func(Episode, error) bool) {
+		position := cursor
+		if position == nil {
+			position = &EpisodeCursor{}
+		}
+		var pageParams GetEpisodesParams
+		if params != nil {
+			pageParams = *params
+		}
+		if pageParams.Max == 0 {
+			pageParams.Max = maxEpisodesPerPage
+		}
+		for {
+			if !position.Since.IsZero() {
+				pageParams.Since = position.Since
+			}
+			if pageParams.Since.IsZero() {
+				pageParams.Since = feedHistoryStart
+			}
+			episodes, err := c.GetEpisodesByFeedID(ctx, feedID, &pageParams)
+			if err != nil {
+				yield(Episode{}, err)
+				return
+			}
+			page := *episodes
+			slices.SortStableFunc(page, func(a, b Episode) int {
+				return cmp.Or(a.DatePublished.Compare(b.DatePublished), cmp.Compare(a.ID, b.ID))
+			})
+			yielded := 0
+			for _, e := range page {
+				if e.DatePublished.Before(pageParams.Since) ||
+					(e.DatePublished.Equal(position.Since) && slices.Contains(position.SeenAtSince, e.ID)) {
+					continue
+				}
+				if e.DatePublished.After(position.Since) {
+					position.Since = e.DatePublished
+					position.SeenAtSince = nil
+				}
+				position.SeenAtSince = append(position.SeenAtSince, e.ID)
+				yielded++
+				if !yield(e, nil) {
+					return
+				}
+			}
+			if len(page) < pageParams.Max {
+				return
+			}
+			if yielded == 0 {
+				yield(Episode{}, fmt.Errorf("%w: feed %d has more than %d episodes published at %s", ErrHistoryTruncated, feedID, pageParams.Max, pageParams.Since))
+				return
+			}
+		}
+	}
+}
+
+// AllRecentEpisodes iterates over the most recent episodes added to the index, newest first, from cursor onwards,
+// fetching pages with the API's "before" parameter as the iteration proceeds, and updating cursor as each episode
+// is yielded. Episodes the API returns on more than one page are only yielded once.
+//
+// cursor (Optional) is where to resume from; nil starts from params.Before, or the newest episode.
+// params (Optional): params.Max is the page size, defaulting to 1000, the most the API returns.
+//
+// Iteration stops after the first error, which is yielded with an empty episode.
+func (c *Client) AllRecentEpisodes(ctx context.Context, params *RecentEpisodesParams, cursor *EpisodeCursor) iter.Seq2[Episode, error] {
+	return func(yield func(Episode, error) bool) {
+		position := cursor
+		if position == nil {
+			position = &EpisodeCursor{}
+		}
+		var pageParams RecentEpisodesParams
+		if params != nil {
+			pageParams = *params
+		}
+		if pageParams.Max == 0 {
+			pageParams.Max = maxEpisodesPerPage
+		}
+		if position.Before == 0 {
+			position.Before = pageParams.Before
+		}
+		for {
+			boundary := position.Before
+			pageParams.Before = boundary
+			episodes, err := c.RecentEpisodes(ctx, &pageParams)
+			if err != nil {
+				yield(Episode{}, err)
+				return
+			}
+			yielded := 0
+			for _, e := range *episodes {
+				if boundary != 0 && e.ID >= boundary {
+					continue
+				}
+				if position.Before == 0 || e.ID < position.Before {
+					position.Before = e.ID
+				}
+				yielded++
+				if !yield(e, nil) {
+					return
+				}
+			}
+			if yielded == 0 {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package podcastindex
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/episode"
+)
+
+// testEpisodeJSON is an episode as returned by the API.
+type testEpisodeJSON struct {
+	ID            int   `json:"id"`
+	DatePublished int64 `json:"datePublished"`
+}
+
+// getEpisodeHistoryServer returns a client for a server serving episodes like the API: for /episodes/byfeedid, those
+// published at or after "since", oldest first, or the newest first without it; for /recent/episodes, those with IDs at
+// or below "before", newest first. Both boundaries are inclusive, so the iterators must deduplicate them.
+func getEpisodeHistoryServer(t *testing.T, episodes []testEpisodeJSON) (*Client, *[]url.Values) {
+	t.Helper()
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		queries = append(queries, query)
+		maxResults, _ := strconv.Atoi(query.Get("max"))
+		since, _ := strconv.ParseInt(query.Get("since"), 10, 64)
+		before, _ := strconv.Atoi(query.Get("before"))
+		sorted := slices.Clone(episodes)
+		slices.SortFunc(sorted, func(a, b testEpisodeJSON) int {
+			switch {
+			case r.URL.Path == "/recent/episodes":
+				return b.ID - a.ID
+			case query.Has("since"):
+				return cmp.Or(cmp.Compare(a.DatePublished, b.DatePublished), a.ID-b.ID)
+			}
+			return cmp.Or(cmp.Compare(b.DatePublished, a.DatePublished), b.ID-a.ID)
+		})
+		items := []testEpisodeJSON{}
+		for _, e := range sorted {
+			if e.DatePublished >= since && (before == 0 || e.ID <= before) && len(items) < maxResults {
+				items = append(items, e)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"status": "true", "items": items, "count": len(items)})
+	}))
+	t.Cleanup(server.Close)
+	serverURL, _ := url.Parse(server.URL)
+	return NewClient(NewClientOptions{BaseURL: serverURL}), &queries
+}
+
+func collectEpisodeIDs(t *testing.T, seq func(yield func(Episode, error) bool)) ([]int, []error) {
+	t.Helper()
+	var ids []int
+	var errs []error
+	for e, err := range seq {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ids = append(ids, int(e.ID))
+	}
+	return ids, errs
+}
+
+func TestAllEpisodesByFeedID(t *testing.T) {
+	history := []testEpisodeJSON{{1, 100}, {2, 200}, {3, 200}, {4, 300}}
+	client, queries := getEpisodeHistoryServer(t, history)
+
+	cursor := &EpisodeCursor{}
+	ids, errs := collectEpisodeIDs(t, client.AllEpisodesByFeedID(context.Background(), testValidFeedID, nil, cursor))
+	if len(errs) != 0 || fmt.Sprint(ids) != "[1 2 3 4]" {
+		t.Fatalf("expected episodes 1 to 4 oldest first, without duplicates, got %v and %v", ids, errs)
+	}
+	if cursor.Since.Unix() != 300 || fmt.Sprint(cursor.SeenAtSince) != "[4]" {
+		t.Errorf("expected the cursor to be at episode 4, got %+v", cursor)
+	}
+	if len(*queries) != 1 || (*queries)[0].Get("max") != "1000" || (*queries)[0].Get("since") != "0" {
+		t.Errorf("expected a single page of 1000 from the first episode, got %v", *queries)
+	}
+
+	t.Run("resumes from the cursor", func(t *testing.T) {
+		checkpoint, _ := json.Marshal(cursor)
+		resumed := &EpisodeCursor{}
+		_ = json.Unmarshal(checkpoint, resumed)
+		client, _ := getEpisodeHistoryServer(t, append(history, testEpisodeJSON{5, 300}, testEpisodeJSON{6, 400}))
+		ids, errs := collectEpisodeIDs(t, client.AllEpisodesByFeedID(context.Background(), testValidFeedID, nil, resumed))
+		if len(errs) != 0 || fmt.Sprint(ids) != "[5 6]" {
+			t.Errorf("expected only the new episodes 5 and 6, got %v and %v", ids, errs)
+		}
+	})
+	t.Run("walks a history longer than a page", func(t *testing.T) {
+		var long []testEpisodeJSON
+		for i := range 2500 {
+			long = append(long, testEpisodeJSON{i + 1, int64(100 + i/2)})
+		}
+		client, queries := getEpisodeHistoryServer(t, long)
+		ids, errs := collectEpisodeIDs(t, client.AllEpisodesByFeedID(context.Background(), testValidFeedID, nil, nil))
+		if len(errs) != 0 || len(ids) != len(long) || !slices.IsSorted(ids) || ids[0] != 1 {
+			t.Fatalf("expected all %d episodes once, oldest first, got %d and %v", len(long), len(ids), errs)
+		}
+		if len(*queries) != 3 || (*queries)[1].Get("since") != "599" {
+			t.Errorf("expected 3 pages, each since the newest episode of the previous one, got %v", *queries)
+		}
+	})
+	t.Run("stops when more episodes than a page were published at the same time", func(t *testing.T) {
+		client, _ := getEpisodeHistoryServer(t, []testEpisodeJSON{{1, 100}, {2, 500}, {3, 500}, {4, 500}, {5, 600}})
+		cursor := &EpisodeCursor{}
+		ids, errs := collectEpisodeIDs(t, client.AllEpisodesByFeedID(context.Background(), testValidFeedID, &GetEpisodesParams{Max: 2}, cursor))
+		if fmt.Sprint(ids) != "[1 2 3]" || len(errs) != 1 || !errors.Is(errs[0], ErrHistoryTruncated) {
+			t.Errorf("expected episodes 1 to 3, then ErrHistoryTruncated, got %v and %v", ids, errs)
+		}
+		if cursor.Since.Unix() != 500 || fmt.Sprint(cursor.SeenAtSince) != "[2 3]" {
+			t.Errorf("expected the cursor to be at episode 3, got %+v", cursor)
+		}
+	})
+	t.Run("a feed with exactly a page of episodes is not truncated", func(t *testing.T) {
+		ids, errs := collectEpisodeIDs(t, client.AllEpisodesByFeedID(context.Background(), testValidFeedID, &GetEpisodesParams{Max: 4}, nil))
+		if len(errs) != 0 || fmt.Sprint(ids) != "[1 2 3 4]" {
+			t.Errorf("expected episodes 1 to 4, got %v and %v", ids, errs)
+		}
+	})
+	t.Run("full pages start from the cursor", func(t *testing.T) {
+		ids, errs := collectEpisodeIDs(t, client.AllEpisodesByFeedID(context.Background(), testValidFeedID, &GetEpisodesParams{Max: 3}, &EpisodeCursor{Since: time.Unix(200, 0), SeenAtSince: []episode.ID{2}}))
+		if len(errs) != 0 || fmt.Sprint(ids) != "[3 4]" {
+			t.Errorf("expected episodes 3 and 4, got %v and %v", ids, errs)
+		}
+	})
+	t.Run("stops after an error", func(t *testing.T) {
+		_, errs := collectEpisodeIDs(t, GetErrorServer(t).AllEpisodesByFeedID(context.Background(), testValidFeedID, nil, nil))
+		if len(errs) != 1 {
+			t.Errorf("expected a single error, got %v", errs)
+		}
+	})
+}
+
+func TestAllRecentEpisodes(t *testing.T) {
+	history := []testEpisodeJSON{{10, 1}, {11, 2}, {12, 3}, {13, 4}, {14, 5}}
+	client, queries := getEpisodeHistoryServer(t, history)
+
+	cursor := &EpisodeCursor{}
+	var ids []int
+	for e, err := range client.AllRecentEpisodes(context.Background(), &RecentEpisodesParams{Max: 2}, cursor) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, int(e.ID))
+		if len(ids) == 3 {
+			break
+		}
+	}
+	if fmt.Sprint(ids) != "[14 13 12]" || cursor.Before != 12 {
+		t.Fatalf("expected episodes 14 to 12, and the cursor at 12, got %v and %+v", ids, cursor)
+	}
+	if (*queries)[1].Get("before") != "13" {
+		t.Errorf("expected the second page to be before episode 13, got %v", (*queries)[1])
+	}
+
+	t.Run("resumes from the cursor", func(t *testing.T) {
+		ids, errs := collectEpisodeIDs(t, client.AllRecentEpisodes(context.Background(), &RecentEpisodesParams{Max: 2}, cursor))
+		if len(errs) != 0 || fmt.Sprint(ids) != "[11 10]" {
+			t.Errorf("expected episodes 11 and 10, got %v and %v", ids, errs)
+		}
+	})
+	t.Run("stops after an error", func(t *testing.T) {
+		_, errs := collectEpisodeIDs(t, GetErrorServer(t).AllRecentEpisodes(context.Background(), nil, nil))
+		if len(errs) != 1 {
+			t.Errorf("expected a single error, got %v", errs)
+		}
+	})
+}
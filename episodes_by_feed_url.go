@@ -0,0 +1,20 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+)
+
+// GetEpisodesByFeedURL returns the episodes of the podcast with the given feed url, in reverse chronological order.
+//
+// Also accepts optional parameters to filter the results, see GetEpisodesParams for more details.
+func (c *Client) GetEpisodesByFeedURL(ctx context.Context, feedURL url.URL, params *GetEpisodesParams) (*[]Episode, error) {
+	var response getEpisodeResponse
+	urlParams := url.Values{"url": {feedURL.String()}}
+	params.encode(urlParams)
+	err := c.api.Get(ctx, "/episodes/byfeedurl", urlParams, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Items, nil
+}
@@ -0,0 +1,56 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+)
+
+var testEpisodesFeedURL = url.URL{Scheme: "https", Host: "mp3s.nashownotes.com", Path: "/pc20rss.xml"}
+
+func TestGetEpisodesByFeedURLRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		params := GetEpisodesParams{
+			Max:      5,
+			Since:    time.Unix(1613805000, 0),
+			FullText: true,
+		}
+		expectedQuery := url.Values{
+			"url":      {testEpisodesFeedURL.String()},
+			"max":      {"5"},
+			"since":    {"1613805000"},
+			"fulltext": {},
+		}
+
+		_, _ = client.GetEpisodesByFeedURL(context.Background(), testEpisodesFeedURL, &params)
+		if err := searchServer.ExpectPathAndQuery("/episodes/byfeedurl", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).GetEpisodesByFeedURL(context.Background(), testEpisodesFeedURL, nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestGetEpisodesByFeedURLIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	episodes, err := client.GetEpisodesByFeedURL(context.Background(), testEpisodesFeedURL, &GetEpisodesParams{Max: 5})
+	if err != nil {
+		t.Fatalf("GetEpisodesByFeedURL failed: %v", err)
+	}
+	if len(*episodes) == 0 {
+		t.Fatalf("No episodes found")
+	}
+}
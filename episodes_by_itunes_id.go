@@ -0,0 +1,22 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// GetEpisodesByITunesID returns the episodes of the podcast with the given iTunes ID, in reverse chronological order.
+//
+// Also accepts optional parameters to filter the results, see GetEpisodesParams for more details.
+func (c *Client) GetEpisodesByITunesID(ctx context.Context, itunesID podcast.ITunesID, params *GetEpisodesParams) (*[]Episode, error) {
+	var response getEpisodeResponse
+	urlParams := url.Values{"id": {string(itunesID)}}
+	params.encode(urlParams)
+	err := c.api.Get(ctx, "/episodes/byitunesid", urlParams, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Items, nil
+}
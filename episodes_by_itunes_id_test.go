@@ -0,0 +1,46 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+)
+
+func TestGetEpisodesByITunesIDRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		expectedQuery := url.Values{
+			"id":       {"1584274529"},
+			"fulltext": {},
+		}
+
+		_, _ = client.GetEpisodesByITunesID(context.Background(), "1584274529", &GetEpisodesParams{FullText: true})
+		if err := searchServer.ExpectPathAndQuery("/episodes/byitunesid", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).GetEpisodesByITunesID(context.Background(), "1584274529", nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestGetEpisodesByITunesIDIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	episodes, err := client.GetEpisodesByITunesID(context.Background(), "1584274529", &GetEpisodesParams{Max: 5})
+	if err != nil {
+		t.Fatalf("GetEpisodesByITunesID failed: %v", err)
+	}
+	if len(*episodes) == 0 {
+		t.Fatalf("No episodes found")
+	}
+}
@@ -0,0 +1,22 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// GetEpisodesByPodcastGUID returns the episodes of the podcast with the given podcast.GUID, in reverse chronological order.
+//
+// Also accepts optional parameters to filter the results, see GetEpisodesParams for more details.
+func (c *Client) GetEpisodesByPodcastGUID(ctx context.Context, guid podcast.GUID, params *GetEpisodesParams) (*[]Episode, error) {
+	var response getEpisodeResponse
+	urlParams := url.Values{"guid": {string(guid)}}
+	params.encode(urlParams)
+	err := c.api.Get(ctx, "/episodes/bypodcastguid", urlParams, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Items, nil
+}
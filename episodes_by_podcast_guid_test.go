@@ -0,0 +1,46 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+)
+
+func TestGetEpisodesByPodcastGUIDRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		expectedQuery := url.Values{
+			"guid": {string(testValuePodcastGUID)},
+			"max":  {"5"},
+		}
+
+		_, _ = client.GetEpisodesByPodcastGUID(context.Background(), testValuePodcastGUID, &GetEpisodesParams{Max: 5})
+		if err := searchServer.ExpectPathAndQuery("/episodes/bypodcastguid", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).GetEpisodesByPodcastGUID(context.Background(), testValuePodcastGUID, nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestGetEpisodesByPodcastGUIDIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	episodes, err := client.GetEpisodesByPodcastGUID(context.Background(), testValuePodcastGUID, &GetEpisodesParams{Max: 5})
+	if err != nil {
+		t.Fatalf("GetEpisodesByPodcastGUID failed: %v", err)
+	}
+	if len(*episodes) == 0 {
+		t.Fatalf("No episodes found")
+	}
+}
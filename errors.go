@@ -0,0 +1,27 @@
+package podcastindex
+
+import "github.com/jjgmckenzie/podcastindex/internal"
+
+// APIError is the error returned when the PodcastIndex API responds to a request with an error.
+//
+// It carries the status code, endpoint, the API's description and the raw response body; use errors.As to inspect it.
+// It wraps one of ErrUnauthorized, ErrNotFound, ErrRateLimited, ErrBadRequest or ErrServer where the response
+// matches, so errors.Is can be used to check the kind of error without inspecting the status code.
+type APIError = internal.APIError
+
+var (
+	// ErrUnauthorized is wrapped by the error returned when the API rejects the request's credentials (401/403);
+	// check the API key and secret.
+	ErrUnauthorized = internal.ErrUnauthorized
+	// ErrNotFound is wrapped by the error returned when the API responds with 404 Not Found, or reports
+	// "status": "false" for a lookup it found no result for, e.g. an unknown feed ID.
+	ErrNotFound = internal.ErrNotFound
+	// ErrRateLimited is wrapped by the error returned when the API responds with 429 Too Many Requests.
+	ErrRateLimited = internal.ErrRateLimited
+	// ErrBadRequest is wrapped by the error returned when the API responds with 400 Bad Request.
+	ErrBadRequest = internal.ErrBadRequest
+	// ErrServer is wrapped by the error returned when the API responds with a 5xx status code.
+	ErrServer = internal.ErrServer
+	// ErrDecode is wrapped by the error returned when a response from the API cannot be decoded.
+	ErrDecode = internal.ErrDecode
+)
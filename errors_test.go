@@ -0,0 +1,46 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestClientErrors(t *testing.T) {
+	t.Run("a missing feed reported with status false is ErrNotFound", func(t *testing.T) {
+		client := GetJSONServer(t, `{"status": "false", "query": {"id": "0"}, "description": "No feeds match this id."}`)
+		p, err := client.GetPodcastByFeedID(context.Background(), testInvalidFeedID)
+		if !errors.Is(err, ErrNotFound) {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+		if p != nil {
+			t.Errorf("expected no podcast to be returned, got %+v", p)
+		}
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.Description != "No feeds match this id." {
+			t.Errorf("expected an *APIError with the API's description, got %v", err)
+		}
+	})
+	t.Run("status codes are distinguishable with errors.Is", func(t *testing.T) {
+		expected := map[int]error{
+			http.StatusUnauthorized:        ErrUnauthorized,
+			http.StatusTooManyRequests:     ErrRateLimited,
+			http.StatusBadRequest:          ErrBadRequest,
+			http.StatusServiceUnavailable:  ErrServer,
+			http.StatusInternalServerError: ErrServer,
+		}
+		for statusCode, sentinel := range expected {
+			_, err := GetStatusServer(t, statusCode).Categories(context.Background())
+			if !errors.Is(err, sentinel) {
+				t.Errorf("expected status %d to wrap %v, got %v", statusCode, sentinel, err)
+			}
+		}
+	})
+	t.Run("an undecodable response is ErrDecode", func(t *testing.T) {
+		_, err := GetJSONServer(t, `{"feeds": "not a list"}`).Categories(context.Background())
+		if !errors.Is(err, ErrDecode) {
+			t.Fatalf("expected ErrDecode, got %v", err)
+		}
+	})
+}
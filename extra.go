@@ -0,0 +1,24 @@
+package podcastindex
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ExtraField decodes the field called name from the Extra fields of a Podcast or Episode, i.e. a field of the API's
+// JSON which this library does not model yet. For example:
+//
+//	newField, ok, err := podcastindex.ExtraField[string](podcast.Extra, "newField")
+//
+// Returns: false if there is no such field, or an error if it cannot be decoded into a T
+func ExtraField[T any](extra map[string]json.RawMessage, name string) (T, bool, error) {
+	var value T
+	raw, ok := extra[name]
+	if !ok {
+		return value, false, nil
+	}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return value, true, fmt.Errorf("failed to decode extra field %q: %w", name, err)
+	}
+	return value, true, nil
+}
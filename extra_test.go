@@ -0,0 +1,79 @@
+package podcastindex
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestEpisodeExtraRoundTrips(t *testing.T) {
+	original := `{"id": 16795090, "title": "Episode", "feedId": 75075, "newField": {"nested": [1, 2]}}`
+	var episode Episode
+	if err := json.Unmarshal([]byte(original), &episode); err != nil {
+		t.Fatal(err)
+	}
+	if len(episode.Extra) != 1 || string(episode.Extra["newField"]) != `{"nested": [1, 2]}` {
+		t.Fatalf("expected newField to be kept in Extra, got %v", episode.Extra)
+	}
+	remarshaled, err := json.Marshal(&episode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(remarshaled, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if string(fields["newField"]) != `{"nested":[1,2]}` {
+		t.Errorf("expected newField to be marshalled again, got %s", fields["newField"])
+	}
+}
+
+func TestExtraCannotOverrideModelledFields(t *testing.T) {
+	podcast := Podcast{ID: 75075, Extra: map[string]json.RawMessage{"id": json.RawMessage(`1`)}}
+	encoded, err := json.Marshal(&podcast)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Podcast
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.ID != 75075 || decoded.Extra != nil {
+		t.Errorf("expected the modelled ID to be kept, got %d with extra %v", decoded.ID, decoded.Extra)
+	}
+}
+
+func TestExtraIgnoresModelledFieldsInAnotherCase(t *testing.T) {
+	var podcast Podcast
+	if err := json.Unmarshal([]byte(`{"id": 75075, "Title": "Batman University"}`), &podcast); err != nil {
+		t.Fatal(err)
+	}
+	if podcast.Title != "Batman University" || podcast.Extra != nil {
+		t.Fatalf("expected Title to be decoded into the title, got %q with extra %v", podcast.Title, podcast.Extra)
+	}
+	encoded, err := json.Marshal(&podcast)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(encoded), `"Title"`) {
+		t.Errorf("expected the title to be encoded once, got %s", encoded)
+	}
+}
+
+func TestExtraField(t *testing.T) {
+	extra := map[string]json.RawMessage{"count": json.RawMessage(`3`), "name": json.RawMessage(`"podcast"`)}
+
+	count, ok, err := ExtraField[int](extra, "count")
+	if err != nil || !ok || count != 3 {
+		t.Errorf("expected 3, got %d, %v, %v", count, ok, err)
+	}
+	if _, ok, err := ExtraField[int](extra, "missing"); ok || err != nil {
+		t.Errorf("expected a missing field to not be found, got %v, %v", ok, err)
+	}
+	if _, ok, err := ExtraField[int](extra, "name"); !ok || err == nil {
+		t.Errorf("expected an error decoding a string into an int, got %v, %v", ok, err)
+	}
+	if _, ok, _ := ExtraField[string](nil, "name"); ok {
+		t.Error("expected no fields in nil Extra")
+	}
+}
@@ -0,0 +1,46 @@
+package fakeindex
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// AdminPath is the path of the admin endpoints, which configure a Server over HTTP, e.g. for the fakeindex command:
+//
+//	GET    /fakeindex/faults    lists the injected faults
+//	POST   /fakeindex/faults    injects the Fault in the request body
+//	DELETE /fakeindex/faults    removes every injected fault
+//	POST   /fakeindex/fixtures  loads the Fixture in the request body
+//	POST   /fakeindex/reset     removes every podcast, episode, category and fault
+//
+// The admin endpoints are not authenticated, and are not affected by faults.
+const AdminPath = "/fakeindex/"
+
+func (s *Server) serveAdmin(w http.ResponseWriter, r *http.Request) {
+	switch r.Method + " " + r.URL.Path {
+	case "GET " + AdminPath + "faults":
+		writeJSON(w, s.Faults())
+	case "POST " + AdminPath + "faults":
+		var fault Fault
+		if err := json.NewDecoder(r.Body).Decode(&fault); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		s.Inject(fault)
+		w.WriteHeader(http.StatusNoContent)
+	case "DELETE " + AdminPath + "faults":
+		s.ClearFaults()
+		w.WriteHeader(http.StatusNoContent)
+	case "POST " + AdminPath + "fixtures":
+		if err := s.LoadFixture(r.Body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case "POST " + AdminPath + "reset":
+		s.Reset()
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusNotFound, "Admin endpoint not found.")
+	}
+}
@@ -0,0 +1,63 @@
+package fakeindex
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAdmin(t *testing.T) {
+	server := NewServer()
+	httpServer := NewTestServer(t, server)
+
+	do := func(method, path, body string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(method, httpServer.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := httpServer.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = resp.Body.Close() })
+		return resp
+	}
+
+	if resp := do(http.MethodPost, AdminPath+"faults", `{"endpoint": "/search/byterm", "statusCode": 429, "retryAfter": "2s"}`); resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204 injecting a fault, got %d", resp.StatusCode)
+	}
+	resp := do(http.MethodGet, AdminPath+"faults", "")
+	var faults []Fault
+	if err := json.NewDecoder(resp.Body).Decode(&faults); err != nil {
+		t.Fatal(err)
+	}
+	if len(faults) != 1 || faults[0].StatusCode != 429 {
+		t.Errorf("expected the injected fault, got %+v", faults)
+	}
+	if resp := do(http.MethodGet, BasePath+"/search/byterm?q=batman", ""); resp.StatusCode != http.StatusTooManyRequests || resp.Header.Get("Retry-After") != "2" {
+		t.Errorf("expected 429 with a Retry-After of 2, got %d %q", resp.StatusCode, resp.Header.Get("Retry-After"))
+	}
+	do(http.MethodDelete, AdminPath+"faults", "")
+	if faults := server.Faults(); len(faults) != 0 {
+		t.Errorf("expected the faults to be cleared, got %+v", faults)
+	}
+
+	if resp := do(http.MethodPost, AdminPath+"fixtures", `{"categories": [{"id": 1, "name": "Arts"}]}`); resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204 loading a fixture, got %d", resp.StatusCode)
+	}
+	if len(server.categories) != 1 {
+		t.Errorf("expected the fixture to be loaded, got %v", server.categories)
+	}
+	if resp := do(http.MethodPost, AdminPath+"fixtures", `not json`); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected 400 loading an invalid fixture, got %d", resp.StatusCode)
+	}
+	do(http.MethodPost, AdminPath+"reset", "")
+	if len(server.categories) != 0 {
+		t.Errorf("expected the dataset to be reset, got %v", server.categories)
+	}
+	if resp := do(http.MethodGet, AdminPath+"unknown", ""); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown admin endpoint, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,158 @@
+package fakeindex
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Fault is a failure injected into the responses of a Server, e.g. latency, an error, or 429 Too Many Requests.
+//
+// Faults are encoded as JSON with their durations as strings, e.g. {"endpoint": "/search/byterm", "latency": "250ms"}.
+type Fault struct {
+	// Endpoint (Optional) is the endpoint the fault applies to, e.g. "/search/byterm"; empty applies it to every endpoint.
+	Endpoint string
+	// Latency (Optional) delays the response, unless the request is cancelled first.
+	Latency time.Duration
+	// StatusCode (Optional) is responded with in place of the endpoint's response; zero responds as normal.
+	StatusCode int
+	// Body (Optional) is the body of the StatusCode response; defaults to a description in the API's error format.
+	Body string
+	// RetryAfter (Optional) sets the Retry-After header of the StatusCode response, in whole seconds.
+	RetryAfter time.Duration
+	// Times (Optional) is how many requests the fault applies to before it is removed; zero applies it to every request.
+	Times int
+}
+
+// RateLimited returns a Fault which responds 429 Too Many Requests to the next times requests to endpoint,
+// or every endpoint if endpoint is empty, asking clients to retry after retryAfter.
+func RateLimited(endpoint string, times int, retryAfter time.Duration) Fault {
+	return Fault{Endpoint: endpoint, StatusCode: http.StatusTooManyRequests, RetryAfter: retryAfter, Times: times}
+}
+
+// faultJSON is the JSON encoding of a Fault.
+type faultJSON struct {
+	Endpoint   string `json:"endpoint,omitempty"`
+	Latency    string `json:"latency,omitempty"`
+	StatusCode int    `json:"statusCode,omitempty"`
+	Body       string `json:"body,omitempty"`
+	RetryAfter string `json:"retryAfter,omitempty"`
+	Times      int    `json:"times,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface for Fault, encoding its durations as strings.
+func (f Fault) MarshalJSON() ([]byte, error) {
+	aux := faultJSON{Endpoint: f.Endpoint, StatusCode: f.StatusCode, Body: f.Body, Times: f.Times}
+	if f.Latency != 0 {
+		aux.Latency = f.Latency.String()
+	}
+	if f.RetryAfter != 0 {
+		aux.RetryAfter = f.RetryAfter.String()
+	}
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Fault, parsing its durations with time.ParseDuration.
+func (f *Fault) UnmarshalJSON(data []byte) error {
+	var aux faultJSON
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*f = Fault{Endpoint: aux.Endpoint, StatusCode: aux.StatusCode, Body: aux.Body, Times: aux.Times}
+	var err error
+	if aux.Latency != "" {
+		if f.Latency, err = time.ParseDuration(aux.Latency); err != nil {
+			return fmt.Errorf("failed to parse fault latency: %w", err)
+		}
+	}
+	if aux.RetryAfter != "" {
+		if f.RetryAfter, err = time.ParseDuration(aux.RetryAfter); err != nil {
+			return fmt.Errorf("failed to parse fault retryAfter: %w", err)
+		}
+	}
+	return nil
+}
+
+// Inject adds a fault to the responses of the Server.
+//
+// Every fault matching a request applies to it: their latencies are added together, and the first with a StatusCode
+// is responded with.
+func (s *Server) Inject(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// Faults returns the faults injected into the Server which have not been removed.
+func (s *Server) Faults() []Fault {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	faults := make([]Fault, 0, len(s.faults))
+	for _, fault := range s.faults {
+		faults = append(faults, *fault)
+	}
+	return faults
+}
+
+// ClearFaults removes every fault injected into the Server.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// applyFaults applies the faults matching a request to endpoint, counting the request against their Times.
+//
+// Returns: false if the request has been responded to, with the fault's StatusCode, or was cancelled
+func (s *Server) applyFaults(w http.ResponseWriter, r *http.Request, endpoint string) bool {
+	var latency time.Duration
+	var failure *Fault
+	s.mu.Lock()
+	remaining := s.faults[:0]
+	for _, fault := range s.faults {
+		if fault.Endpoint != "" && fault.Endpoint != endpoint {
+			remaining = append(remaining, fault)
+			continue
+		}
+		latency += fault.Latency
+		if failure == nil && fault.StatusCode != 0 {
+			applied := *fault
+			failure = &applied
+		}
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				continue
+			}
+		}
+		remaining = append(remaining, fault)
+	}
+	clear(s.faults[len(remaining):])
+	s.faults = remaining
+	s.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-r.Context().Done():
+			return false
+		}
+	}
+	if failure == nil {
+		return true
+	}
+	if failure.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(failure.RetryAfter.Round(time.Second)/time.Second)))
+	}
+	if failure.Body == "" {
+		writeError(w, failure.StatusCode, http.StatusText(failure.StatusCode))
+		return false
+	}
+	w.WriteHeader(failure.StatusCode)
+	_, _ = w.Write([]byte(failure.Body))
+	return false
+}
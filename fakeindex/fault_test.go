@@ -0,0 +1,105 @@
+package fakeindex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex"
+)
+
+func TestRateLimited(t *testing.T) {
+	server := NewServer()
+	server.Inject(RateLimited("/categories/list", 2, 3*time.Second))
+	client := NewClient(t, server, podcastindex.NewClientOptions{})
+	ctx := context.Background()
+
+	for range 2 {
+		_, err := client.Categories(ctx)
+		var apiErr *podcastindex.APIError
+		if !errors.As(err, &apiErr) || !errors.Is(err, podcastindex.ErrRateLimited) {
+			t.Fatalf("expected ErrRateLimited, got %v", err)
+		}
+		if apiErr.RetryAfter != 3*time.Second {
+			t.Errorf("expected a Retry-After of 3s, got %v", apiErr.RetryAfter)
+		}
+	}
+	if _, err := client.Categories(ctx); err != nil {
+		t.Fatalf("expected the fault to be removed after 2 requests, got %v", err)
+	}
+	if faults := server.Faults(); len(faults) != 0 {
+		t.Errorf("expected no faults, got %v", faults)
+	}
+}
+
+func TestFaultEndpoint(t *testing.T) {
+	server := NewServer()
+	server.Inject(Fault{Endpoint: "/search/byterm", StatusCode: http.StatusInternalServerError})
+	client := NewClient(t, server, podcastindex.NewClientOptions{})
+	ctx := context.Background()
+
+	if _, err := client.SearchPodcastsByTerm(ctx, "batman", nil); !errors.Is(err, podcastindex.ErrServer) {
+		t.Errorf("expected ErrServer, got %v", err)
+	}
+	if _, err := client.Categories(ctx); err != nil {
+		t.Errorf("expected other endpoints to be unaffected, got %v", err)
+	}
+	server.ClearFaults()
+	if _, err := client.SearchPodcastsByTerm(ctx, "batman", nil); err != nil {
+		t.Errorf("expected no error once the faults are cleared, got %v", err)
+	}
+}
+
+func TestFaultLatency(t *testing.T) {
+	server := NewServer()
+	server.Inject(Fault{Latency: time.Minute})
+	client := NewClient(t, server, podcastindex.NewClientOptions{})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Categories(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the request to time out, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("expected the request to be cancelled, took %v", elapsed)
+	}
+}
+
+func TestFaultRetried(t *testing.T) {
+	server := NewServer()
+	server.Inject(Fault{StatusCode: http.StatusServiceUnavailable, Times: 1})
+	policy := podcastindex.DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	client := NewClient(t, server, podcastindex.NewClientOptions{RetryPolicy: policy})
+
+	if _, err := client.Categories(context.Background()); err != nil {
+		t.Errorf("expected the request to be retried, got %v", err)
+	}
+}
+
+func TestFaultJSON(t *testing.T) {
+	fault := Fault{Endpoint: "/episodes/live", Latency: 250 * time.Millisecond, StatusCode: 429, RetryAfter: time.Second, Times: 3}
+	encoded, err := json.Marshal(fault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"endpoint":"/episodes/live","latency":"250ms","statusCode":429,"retryAfter":"1s","times":3}`
+	if string(encoded) != expected {
+		t.Errorf("expected %s, got %s", expected, encoded)
+	}
+	var decoded Fault
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != fault {
+		t.Errorf("expected %+v, got %+v", fault, decoded)
+	}
+	if err := json.Unmarshal([]byte(`{"latency":"soon"}`), &decoded); err == nil {
+		t.Error("expected an error decoding an invalid latency")
+	}
+}
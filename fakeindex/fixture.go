@@ -0,0 +1,57 @@
+package fakeindex
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/jjgmckenzie/podcastindex"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// Fixture is a dataset for a Server, in the JSON format of the API: podcasts are listed in "feeds" and episodes in
+// "items", as they are in the responses of the API, so saved search and episodes responses can be used as fixtures.
+// Categories are listed in "categories".
+type Fixture struct {
+	// Podcasts are the podcasts of the dataset.
+	Podcasts []podcastindex.Podcast `json:"feeds"`
+	// Episodes are the episodes of the dataset; they belong to the podcast with their FeedID.
+	Episodes []podcastindex.Episode `json:"items"`
+	// Categories are the categories listed by categories/list.
+	Categories []podcast.Category `json:"categories"`
+}
+
+// Load adds the podcasts, episodes and categories of a fixture to the dataset of the Server.
+func (s *Server) Load(fixture Fixture) {
+	s.AddPodcasts(fixture.Podcasts...)
+	s.AddEpisodes(fixture.Episodes...)
+	s.AddCategories(fixture.Categories...)
+}
+
+// LoadFixture decodes a Fixture from r, and adds it to the dataset of the Server.
+//
+// Returns: an error if the fixture cannot be decoded
+func (s *Server) LoadFixture(r io.Reader) error {
+	var fixture Fixture
+	if err := json.NewDecoder(r).Decode(&fixture); err != nil {
+		return fmt.Errorf("failed to decode fakeindex fixture: %w", err)
+	}
+	s.Load(fixture)
+	return nil
+}
+
+// LoadFixtureFile decodes a Fixture from the file at path, and adds it to the dataset of the Server.
+//
+// Returns: an error if the file cannot be read, or the fixture cannot be decoded
+func (s *Server) LoadFixtureFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open fakeindex fixture: %w", err)
+	}
+	defer func() {
+		// ignore errors closing the file; we do not care about them once we have read it.
+		_ = file.Close()
+	}()
+	return s.LoadFixture(file)
+}
@@ -0,0 +1,50 @@
+package fakeindex
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jjgmckenzie/podcastindex"
+)
+
+func TestLoadFixtureFile(t *testing.T) {
+	server := NewServer()
+	if err := server.LoadFixtureFile("testdata/fixture.json"); err != nil {
+		t.Fatal(err)
+	}
+	client := NewClient(t, server, podcastindex.NewClientOptions{})
+	ctx := context.Background()
+
+	p, err := client.GetPodcastByFeedID(ctx, 920666)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Title != "Podcasting 2.0" || len(p.Categories) != 1 {
+		t.Errorf("unexpected podcast %+v", p)
+	}
+	episodes, err := client.GetEpisodesByFeedID(ctx, 920666, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*episodes) != 1 || (*episodes)[0].GUID != "PC2001" {
+		t.Errorf("expected episode PC2001, got %+v", *episodes)
+	}
+	categories, err := client.Categories(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(categories) != 1 || categories[0].Name != "Arts" {
+		t.Errorf("expected the Arts category, got %v", categories)
+	}
+}
+
+func TestLoadFixtureInvalid(t *testing.T) {
+	server := NewServer()
+	if err := server.LoadFixture(strings.NewReader(`{"feeds": {}}`)); err == nil {
+		t.Error("expected an error decoding an invalid fixture")
+	}
+	if err := server.LoadFixtureFile("testdata/missing.json"); err == nil {
+		t.Error("expected an error loading a missing fixture")
+	}
+}
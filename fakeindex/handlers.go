@@ -0,0 +1,445 @@
+package fakeindex
+
+import (
+	"cmp"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jjgmckenzie/podcastindex"
+	"github.com/jjgmckenzie/podcastindex/episode"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+	"github.com/jjgmckenzie/podcastindex/podcast/value"
+)
+
+// truncatedWords is the number of words text fields are truncated to, unless fulltext is requested.
+const truncatedWords = 100
+
+// feedsResponse is the response of the endpoints which return a list of podcasts, e.g. search/byterm.
+type feedsResponse struct {
+	Status      string                  `json:"status"`
+	Feeds       []*podcastindex.Podcast `json:"feeds"`
+	Count       int                     `json:"count"`
+	Query       string                  `json:"query,omitempty"`
+	NextStartAt int                     `json:"nextStartAt,omitempty"`
+	Description string                  `json:"description"`
+}
+
+// podcastResponse is the response of the endpoints which look up a single podcast, e.g. podcasts/byfeedid.
+type podcastResponse struct {
+	Status      string                `json:"status"`
+	Query       map[string]string     `json:"query"`
+	Feed        *podcastindex.Podcast `json:"feed"`
+	Description string                `json:"description"`
+}
+
+// episodesResponse is the response of the endpoints which return a list of episodes, e.g. episodes/byfeedid.
+type episodesResponse struct {
+	Status      string                  `json:"status"`
+	Query       string                  `json:"query,omitempty"`
+	LiveItems   []*podcastindex.Episode `json:"liveItems,omitempty"`
+	Items       []*podcastindex.Episode `json:"items"`
+	Count       int                     `json:"count"`
+	Description string                  `json:"description"`
+}
+
+// episodeResponse is the response of the endpoints which look up a single episode, e.g. episodes/byid.
+type episodeResponse struct {
+	Status      string                `json:"status"`
+	ID          string                `json:"id"`
+	Episode     *podcastindex.Episode `json:"episode"`
+	Description string                `json:"description"`
+}
+
+// categoriesResponse is the response of the categories/list endpoint.
+type categoriesResponse struct {
+	Status      string             `json:"status"`
+	Feeds       []podcast.Category `json:"feeds"`
+	Count       int                `json:"count"`
+	Description string             `json:"description"`
+}
+
+func (s *Server) searchByTerm(w http.ResponseWriter, r *http.Request) {
+	s.search(w, r, func(p *podcastindex.Podcast, q string) bool {
+		return matchesTerms(p.Title+" "+p.Author+" "+p.OwnerName, q)
+	})
+}
+
+func (s *Server) searchByTitle(w http.ResponseWriter, r *http.Request) {
+	s.search(w, r, func(p *podcastindex.Podcast, q string) bool {
+		return strings.Contains(strings.ToLower(p.Title), strings.ToLower(q))
+	})
+}
+
+// searchByPerson matches the author and owner of podcasts, and the persons, titles and descriptions of their episodes.
+func (s *Server) searchByPerson(w http.ResponseWriter, r *http.Request) {
+	s.search(w, r, func(p *podcastindex.Podcast, q string) bool {
+		if matchesTerms(p.Author+" "+p.OwnerName, q) {
+			return true
+		}
+		for _, e := range s.episodes {
+			if e.FeedID == p.ID && episodeMentions(&e, q) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (s *Server) searchMusicByTerm(w http.ResponseWriter, r *http.Request) {
+	s.search(w, r, func(p *podcastindex.Podcast, q string) bool {
+		return p.Medium == string(podcast.MediumMusic) && matchesTerms(p.Title+" "+p.Author+" "+p.OwnerName, q)
+	})
+}
+
+// search responds with the podcasts matching the q parameter, filtered by the clean, aponly and val parameters.
+func (s *Server) search(w http.ResponseWriter, r *http.Request, match func(p *podcastindex.Podcast, q string) bool) {
+	query := r.URL.Query()
+	q := query.Get("q")
+	if strings.TrimSpace(q) == "" {
+		writeError(w, http.StatusBadRequest, "A search term is required in the q parameter.")
+		return
+	}
+	feeds := s.findPodcasts(query, maxParam(query), func(p *podcastindex.Podcast) bool {
+		if query.Has("clean") && p.Explicit {
+			return false
+		}
+		if query.Has("aponly") && query.Get("aponly") != "false" && p.ITunesID == "" {
+			return false
+		}
+		if val := query.Get("val"); val != "" && !hasValue(p, val) {
+			return false
+		}
+		return match(p, q)
+	})
+	writeJSON(w, feedsResponse{Status: "true", Feeds: feeds, Count: len(feeds), Query: q, Description: "Found matching feeds"})
+}
+
+func (s *Server) podcastByFeedID(w http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get("id")
+	s.lookupPodcast(w, r, "id", func(p *podcastindex.Podcast) bool { return strconv.Itoa(int(p.ID)) == id })
+}
+
+func (s *Server) podcastByFeedURL(w http.ResponseWriter, r *http.Request) {
+	feedURL := r.URL.Query().Get("url")
+	s.lookupPodcast(w, r, "url", func(p *podcastindex.Podcast) bool {
+		return p.URL.String() == feedURL || p.OriginalURL.String() == feedURL
+	})
+}
+
+func (s *Server) podcastByGUID(w http.ResponseWriter, r *http.Request) {
+	guid := r.URL.Query().Get("guid")
+	s.lookupPodcast(w, r, "guid", func(p *podcastindex.Podcast) bool { return string(p.GUID) == guid })
+}
+
+func (s *Server) podcastByITunesID(w http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get("id")
+	s.lookupPodcast(w, r, "id", func(p *podcastindex.Podcast) bool { return p.ITunesID != "" && string(p.ITunesID) == id })
+}
+
+// lookupPodcast responds with the first podcast matching, or "status": "false" if none do, as the API does.
+func (s *Server) lookupPodcast(w http.ResponseWriter, r *http.Request, param string, match func(p *podcastindex.Podcast) bool) {
+	query := r.URL.Query()
+	echo := map[string]string{param: query.Get(param)}
+	for i := range s.podcasts {
+		if match(&s.podcasts[i]) {
+			feed := podcastView(&s.podcasts[i], query.Has("fulltext"))
+			writeJSON(w, podcastResponse{Status: "true", Query: echo, Feed: feed, Description: "Found matching feed"})
+			return
+		}
+	}
+	writeJSON(w, map[string]any{"status": "false", "query": echo, "feed": []any{}, "description": "No feeds match this " + param + "."})
+}
+
+// podcastsByTag responds with the podcasts which have a value block, for both the podcast-value and
+// podcast-valueTimeSplit tags, as the dataset does not record time splits; it pages by feed ID, with start_at.
+func (s *Server) podcastsByTag(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if !query.Has(string(podcast.TagValue)) && !query.Has(string(podcast.TagValueTimeSplit)) {
+		writeError(w, http.StatusBadRequest, "A supported tag is required, e.g. podcast-value.")
+		return
+	}
+	startAt, _ := strconv.Atoi(query.Get("start_at"))
+	var feeds []*podcastindex.Podcast
+	byID := slices.SortedFunc(slices.Values(s.podcastPointers()), func(a, b *podcastindex.Podcast) int { return cmp.Compare(a.ID, b.ID) })
+	for _, p := range byID {
+		if p.Value != nil && int(p.ID) >= startAt {
+			feeds = append(feeds, p)
+		}
+	}
+	// this endpoint returns up to 500 feeds by default, and up to 5000.
+	maxResults, err := strconv.Atoi(query.Get("max"))
+	if err != nil || maxResults <= 0 {
+		maxResults = 500
+	}
+	feeds = feeds[:min(len(feeds), maxResults, 5000)]
+	response := feedsResponse{Status: "true", Feeds: []*podcastindex.Podcast{}, Count: len(feeds), Description: "Found matching feeds"}
+	for _, p := range feeds {
+		response.Feeds = append(response.Feeds, podcastView(p, query.Has("fulltext")))
+		response.NextStartAt = int(p.ID) + 1
+	}
+	writeJSON(w, response)
+}
+
+func (s *Server) podcastsByMedium(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	medium := podcast.Medium(query.Get("medium"))
+	if medium == "" {
+		writeError(w, http.StatusBadRequest, "A medium is required in the medium parameter.")
+		return
+	}
+	feeds := s.findPodcasts(query, maxParam(query), func(p *podcastindex.Podcast) bool { return p.Medium == string(medium) })
+	writeJSON(w, feedsResponse{Status: "true", Feeds: feeds, Count: len(feeds), Query: string(medium), Description: "Found matching feeds"})
+}
+
+func (s *Server) podcastsDead(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	// the API returns every dead feed, regardless of max.
+	feeds := s.findPodcasts(query, len(s.podcasts), func(p *podcastindex.Podcast) bool { return p.Dead })
+	writeJSON(w, feedsResponse{Status: "true", Feeds: feeds, Count: len(feeds), Description: "Found matching feeds"})
+}
+
+func (s *Server) episodesByFeedID(w http.ResponseWriter, r *http.Request) {
+	ids := r.URL.Query().Get("id")
+	var feedIDs []podcast.ID
+	for _, id := range strings.Split(ids, ",") {
+		feedID, err := strconv.Atoi(strings.TrimSpace(id))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "The id parameter must be a feed ID, or a comma separated list of them.")
+			return
+		}
+		feedIDs = append(feedIDs, podcast.ID(feedID))
+	}
+	s.feedEpisodes(w, r, ids, feedIDs...)
+}
+
+func (s *Server) episodesByFeedURL(w http.ResponseWriter, r *http.Request) {
+	feedURL := r.URL.Query().Get("url")
+	s.lookupFeedEpisodes(w, r, feedURL, func(p *podcastindex.Podcast) bool {
+		return p.URL.String() == feedURL || p.OriginalURL.String() == feedURL
+	})
+}
+
+func (s *Server) episodesByITunesID(w http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get("id")
+	s.lookupFeedEpisodes(w, r, id, func(p *podcastindex.Podcast) bool { return p.ITunesID != "" && string(p.ITunesID) == id })
+}
+
+func (s *Server) episodesByPodcastGUID(w http.ResponseWriter, r *http.Request) {
+	guid := r.URL.Query().Get("guid")
+	s.lookupFeedEpisodes(w, r, guid, func(p *podcastindex.Podcast) bool { return string(p.GUID) == guid })
+}
+
+// lookupFeedEpisodes responds with the episodes of the first podcast matching, or "status": "false" if none do.
+func (s *Server) lookupFeedEpisodes(w http.ResponseWriter, r *http.Request, echo string, match func(p *podcastindex.Podcast) bool) {
+	for i := range s.podcasts {
+		if match(&s.podcasts[i]) {
+			s.feedEpisodes(w, r, echo, s.podcasts[i].ID)
+			return
+		}
+	}
+	writeJSON(w, map[string]any{"status": "false", "query": echo, "items": []any{}, "count": 0, "description": "No feeds match this query."})
+}
+
+// feedEpisodes responds with the episodes of the feeds, newest first, or, given the since parameter, those published at
+// or after it, oldest first, as the API does. Live episodes are listed separately, in liveItems, as the API does.
+func (s *Server) feedEpisodes(w http.ResponseWriter, r *http.Request, echo string, feedIDs ...podcast.ID) {
+	query := r.URL.Query()
+	since, _ := strconv.ParseInt(query.Get("since"), 10, 64)
+	order := newestFirst
+	if query.Has("since") {
+		order = func(a, b *podcastindex.Episode) int { return newestFirst(b, a) }
+	}
+	response := episodesResponse{Status: "true", Query: echo, Items: []*podcastindex.Episode{}, Description: "Found matching items"}
+	var items []*podcastindex.Episode
+	for i := range s.episodes {
+		e := &s.episodes[i]
+		if !slices.Contains(feedIDs, e.FeedID) || e.DatePublished.Unix() < since {
+			continue
+		}
+		if e.LivestreamStatus != nil {
+			response.LiveItems = append(response.LiveItems, episodeView(e, query.Has("fulltext")))
+			continue
+		}
+		items = append(items, e)
+	}
+	slices.SortFunc(items, order)
+	for _, e := range items[:min(len(items), maxParam(query))] {
+		response.Items = append(response.Items, episodeView(e, query.Has("fulltext")))
+	}
+	response.Count = len(response.Items)
+	writeJSON(w, response)
+}
+
+func (s *Server) episodeByID(w http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get("id")
+	s.lookupEpisode(w, r, id, func(e *podcastindex.Episode) bool { return strconv.Itoa(int(e.ID)) == id })
+}
+
+// episodeByGUID responds with the episode with the guid parameter, in the feed given by feedid, feedurl or podcastguid.
+func (s *Server) episodeByGUID(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	guid := episode.GUID(query.Get("guid"))
+	var inFeed func(p *podcastindex.Podcast) bool
+	switch {
+	case query.Has("feedid"):
+		inFeed = func(p *podcastindex.Podcast) bool { return strconv.Itoa(int(p.ID)) == query.Get("feedid") }
+	case query.Has("feedurl"):
+		inFeed = func(p *podcastindex.Podcast) bool { return p.URL.String() == query.Get("feedurl") }
+	case query.Has("podcastguid"):
+		inFeed = func(p *podcastindex.Podcast) bool { return string(p.GUID) == query.Get("podcastguid") }
+	default:
+		writeError(w, http.StatusBadRequest, "One of feedid, feedurl or podcastguid is required with guid.")
+		return
+	}
+	feedIDs := map[podcast.ID]bool{}
+	for i := range s.podcasts {
+		if inFeed(&s.podcasts[i]) {
+			feedIDs[s.podcasts[i].ID] = true
+		}
+	}
+	if query.Has("feedid") {
+		// episodes may be added without their podcast.
+		feedID, _ := strconv.Atoi(query.Get("feedid"))
+		feedIDs[podcast.ID(feedID)] = true
+	}
+	s.lookupEpisode(w, r, string(guid), func(e *podcastindex.Episode) bool { return e.GUID == guid && feedIDs[e.FeedID] })
+}
+
+// lookupEpisode responds with the first episode matching, or "status": "false" if none do, as the API does.
+func (s *Server) lookupEpisode(w http.ResponseWriter, r *http.Request, echo string, match func(e *podcastindex.Episode) bool) {
+	for i := range s.episodes {
+		if match(&s.episodes[i]) {
+			item := episodeView(&s.episodes[i], r.URL.Query().Has("fulltext"))
+			writeJSON(w, episodeResponse{Status: "true", ID: echo, Episode: item, Description: "Found matching item"})
+			return
+		}
+	}
+	writeJSON(w, map[string]any{"status": "false", "id": echo, "episode": []any{}, "description": "No episodes match this query."})
+}
+
+// episodesLive responds with the episodes which are live now, most recently started first.
+func (s *Server) episodesLive(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var live []*podcastindex.Episode
+	for i := range s.episodes {
+		e := &s.episodes[i]
+		if e.LivestreamStatus != nil && *e.LivestreamStatus == episode.LivestreamLive {
+			live = append(live, e)
+		}
+	}
+	slices.SortStableFunc(live, func(a, b *podcastindex.Episode) int {
+		if a.StartTime == nil || b.StartTime == nil {
+			return cmp.Compare(b.ID, a.ID)
+		}
+		return cmp.Or(b.StartTime.Compare(*a.StartTime), cmp.Compare(b.ID, a.ID))
+	})
+	response := episodesResponse{Status: "true", Items: []*podcastindex.Episode{}, Description: "Found matching items"}
+	for _, e := range live[:min(len(live), maxParam(query))] {
+		response.Items = append(response.Items, episodeView(e, query.Has("fulltext")))
+	}
+	response.Count = len(response.Items)
+	writeJSON(w, response)
+}
+
+func (s *Server) categoriesList(w http.ResponseWriter, _ *http.Request) {
+	categories := slices.SortedFunc(slices.Values(s.categories), func(a, b podcast.Category) int { return cmp.Compare(a.ID, b.ID) })
+	if categories == nil {
+		categories = []podcast.Category{}
+	}
+	writeJSON(w, categoriesResponse{Status: "true", Feeds: categories, Count: len(categories), Description: "Categories list"})
+}
+
+// findPodcasts returns up to maxResults podcasts matching, in the order they were added, truncated unless the
+// fulltext parameter is present.
+func (s *Server) findPodcasts(query url.Values, maxResults int, match func(p *podcastindex.Podcast) bool) []*podcastindex.Podcast {
+	var matches []*podcastindex.Podcast
+	for _, p := range s.podcastPointers() {
+		if match(p) {
+			matches = append(matches, p)
+		}
+	}
+	feeds := []*podcastindex.Podcast{}
+	for _, p := range matches[:min(len(matches), maxResults)] {
+		feeds = append(feeds, podcastView(p, query.Has("fulltext")))
+	}
+	return feeds
+}
+
+func (s *Server) podcastPointers() []*podcastindex.Podcast {
+	pointers := make([]*podcastindex.Podcast, len(s.podcasts))
+	for i := range s.podcasts {
+		pointers[i] = &s.podcasts[i]
+	}
+	return pointers
+}
+
+// maxParam returns the max parameter; 10 if it is missing or invalid, and at most 1000, as the API documents.
+func maxParam(query url.Values) int {
+	maxResults, err := strconv.Atoi(query.Get("max"))
+	if err != nil || maxResults <= 0 {
+		return 10
+	}
+	return min(maxResults, 1000)
+}
+
+// podcastView returns a copy of p with its description truncated, unless fulltext is set.
+func podcastView(p *podcastindex.Podcast, fulltext bool) *podcastindex.Podcast {
+	view := *p
+	if !fulltext {
+		view.Description = truncate(view.Description)
+	}
+	return &view
+}
+
+// episodeView returns a copy of e with its description truncated, unless fulltext is set.
+func episodeView(e *podcastindex.Episode, fulltext bool) *podcastindex.Episode {
+	view := *e
+	if !fulltext {
+		view.Description = truncate(view.Description)
+	}
+	return &view
+}
+
+// truncate truncates text to its first 100 words, as the API does unless fulltext is requested.
+func truncate(text string) string {
+	words := strings.Fields(text)
+	if len(words) <= truncatedWords {
+		return text
+	}
+	return strings.Join(words[:truncatedWords], " ") + "..."
+}
+
+// matchesTerms reports whether text contains every word of q, ignoring case.
+func matchesTerms(text string, q string) bool {
+	text = strings.ToLower(text)
+	for _, word := range strings.Fields(strings.ToLower(q)) {
+		if !strings.Contains(text, word) {
+			return false
+		}
+	}
+	return true
+}
+
+// episodeMentions reports whether one of the persons of e, or its title or description, matches q.
+func episodeMentions(e *podcastindex.Episode, q string) bool {
+	if e.Persons != nil {
+		for _, person := range *e.Persons {
+			if matchesTerms(person.Name, q) {
+				return true
+			}
+		}
+	}
+	return matchesTerms(e.Title+" "+e.Description, q)
+}
+
+// hasValue reports whether p has a value block of the payment type val, or any value block if val is value.PaymentAny.
+func hasValue(p *podcastindex.Podcast, val string) bool {
+	return p.Value != nil && (val == value.PaymentAny || strings.EqualFold(p.Value.Model.Type, val))
+}
+
+func newestFirst(a, b *podcastindex.Episode) int {
+	return cmp.Or(b.DatePublished.Compare(a.DatePublished), cmp.Compare(b.ID, a.ID))
+}
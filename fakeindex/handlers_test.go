@@ -0,0 +1,318 @@
+package fakeindex
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex"
+	"github.com/jjgmckenzie/podcastindex/episode"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+	"github.com/jjgmckenzie/podcastindex/podcast/value"
+)
+
+func mustParseURL(t *testing.T, rawURL string) url.URL {
+	t.Helper()
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *parsed
+}
+
+// seededServer returns a Server with three podcasts, and the episodes of the first.
+func seededServer(t *testing.T) *Server {
+	t.Helper()
+	server := NewServer()
+	server.AddCategories(podcast.Category{ID: 2, Name: "Books"}, podcast.Category{ID: 1, Name: "Arts"})
+	server.AddPodcasts(
+		podcastindex.Podcast{
+			ID:          920666,
+			GUID:        "917393e3-1b1e-5cef-ace4-edaa54e1f810",
+			Title:       "Podcasting 2.0",
+			URL:         mustParseURL(t, "https://feeds.podcastindex.org/pc20.xml"),
+			Author:      "Podcast Index LLC",
+			Description: strings.Repeat("word ", 150),
+			ITunesID:    "1584274529",
+			Medium:      string(podcast.MediumPodcast),
+			Value:       &podcast.Value{Model: value.Model{Type: value.PaymentLightning}},
+		},
+		podcastindex.Podcast{
+			ID:       75075,
+			Title:    "Batman University",
+			URL:      mustParseURL(t, "https://feeds.theincomparable.com/batmanuniversity"),
+			Author:   "Tony Sindelar",
+			Explicit: true,
+			Medium:   string(podcast.MediumPodcast),
+		},
+		podcastindex.Podcast{
+			ID:     41504,
+			Title:  "Podcast Index Radio",
+			Author: "Podcast Index LLC",
+			Medium: string(podcast.MediumMusic),
+			Dead:   true,
+		},
+	)
+	live := episode.LivestreamLive
+	startTime := time.Unix(4000, 0)
+	server.AddEpisodes(
+		podcastindex.Episode{ID: 1, FeedID: 920666, GUID: "one", Title: "Episode 1", DatePublished: time.Unix(1000, 0)},
+		podcastindex.Episode{ID: 2, FeedID: 920666, GUID: "two", Title: "Episode 2", DatePublished: time.Unix(2000, 0), Description: strings.Repeat("word ", 150)},
+		podcastindex.Episode{ID: 3, FeedID: 920666, GUID: "three", Title: "Episode 3", DatePublished: time.Unix(3000, 0),
+			Persons: &[]episode.Person{{Name: "Dave Jones"}}},
+		podcastindex.Episode{ID: 4, FeedID: 920666, GUID: "live", Title: "Board Meeting", DatePublished: time.Unix(4000, 0),
+			LivestreamStatus: &live, StartTime: &startTime},
+	)
+	return server
+}
+
+func titles(podcasts []*podcastindex.Podcast) []string {
+	var result []string
+	for _, p := range podcasts {
+		result = append(result, p.Title)
+	}
+	return result
+}
+
+func episodeIDs(episodes []podcastindex.Episode) []episode.ID {
+	var result []episode.ID
+	for _, e := range episodes {
+		result = append(result, e.ID)
+	}
+	return result
+}
+
+func TestSearch(t *testing.T) {
+	client := NewClient(t, seededServer(t), podcastindex.NewClientOptions{})
+	ctx := context.Background()
+
+	t.Run("byterm matches every word in the title, author or owner", func(t *testing.T) {
+		podcasts, err := client.SearchPodcastsByTerm(ctx, "podcast llc", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := titles(podcasts); !slices.Equal(got, []string{"Podcasting 2.0", "Podcast Index Radio"}) {
+			t.Errorf("expected both Podcast Index LLC podcasts, got %v", got)
+		}
+	})
+	t.Run("max limits the results", func(t *testing.T) {
+		podcasts, err := client.SearchPodcastsByTerm(ctx, "podcast", &podcastindex.SearchPodcastsByTermParams{Max: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(podcasts) != 1 {
+			t.Errorf("expected 1 podcast, got %d", len(podcasts))
+		}
+	})
+	t.Run("clean excludes explicit podcasts", func(t *testing.T) {
+		podcasts, err := client.SearchPodcastsByTitle(ctx, "batman", &podcastindex.SearchPodcastsByTitleParams{Clean: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(podcasts) != 0 {
+			t.Errorf("expected the explicit podcast to be excluded, got %v", titles(podcasts))
+		}
+	})
+	t.Run("descriptions are truncated to 100 words unless fulltext is set", func(t *testing.T) {
+		truncated, err := client.SearchPodcastsByTerm(ctx, "podcasting", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		full, err := client.SearchPodcastsByTerm(ctx, "podcasting", &podcastindex.SearchPodcastsByTermParams{FullText: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if words := len(strings.Fields(truncated[0].Description)); words != 100 {
+			t.Errorf("expected 100 words, got %d", words)
+		}
+		if words := len(strings.Fields(full[0].Description)); words != 150 {
+			t.Errorf("expected 150 words with fulltext, got %d", words)
+		}
+	})
+	t.Run("aponly and val filter the results", func(t *testing.T) {
+		podcasts, err := client.SearchPodcastsByTerm(ctx, "podcast", &podcastindex.SearchPodcastsByTermParams{APOnly: true, Value: value.PaymentLightning})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := titles(podcasts); !slices.Equal(got, []string{"Podcasting 2.0"}) {
+			t.Errorf("expected only Podcasting 2.0, got %v", got)
+		}
+	})
+	t.Run("byperson matches the persons of episodes", func(t *testing.T) {
+		podcasts, err := client.SearchPodcastsByPerson(ctx, "dave jones", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := titles(podcasts); !slices.Equal(got, []string{"Podcasting 2.0"}) {
+			t.Errorf("expected Podcasting 2.0, got %v", got)
+		}
+	})
+	t.Run("music/byterm only matches music podcasts", func(t *testing.T) {
+		podcasts, err := client.SearchMusicPodcastsByTerm(ctx, "podcast", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := titles(podcasts); !slices.Equal(got, []string{"Podcast Index Radio"}) {
+			t.Errorf("expected Podcast Index Radio, got %v", got)
+		}
+	})
+}
+
+func TestPodcasts(t *testing.T) {
+	client := NewClient(t, seededServer(t), podcastindex.NewClientOptions{})
+	ctx := context.Background()
+
+	t.Run("byfeedid returns the podcast", func(t *testing.T) {
+		p, err := client.GetPodcastByFeedID(ctx, 920666)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Title != "Podcasting 2.0" || p.GUID != "917393e3-1b1e-5cef-ace4-edaa54e1f810" {
+			t.Errorf("unexpected podcast %+v", p)
+		}
+	})
+	t.Run("an unknown feed ID is not found", func(t *testing.T) {
+		_, err := client.GetPodcastByFeedID(ctx, 1)
+		if !errors.Is(err, podcastindex.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+	t.Run("byfeedurl, byguid and byitunesid return the podcast", func(t *testing.T) {
+		byURL, err := client.GetPodcastByURL(ctx, mustParseURL(t, "https://feeds.podcastindex.org/pc20.xml"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		byGUID, err := client.GetPodcastByGUID(ctx, "917393e3-1b1e-5cef-ace4-edaa54e1f810")
+		if err != nil {
+			t.Fatal(err)
+		}
+		byITunesID, err := client.GetPodcastByITunesID(ctx, "1584274529")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range []*podcastindex.Podcast{byURL, byGUID, byITunesID} {
+			if p.ID != 920666 {
+				t.Errorf("expected feed 920666, got %d", p.ID)
+			}
+		}
+	})
+	t.Run("bytag pages through the podcasts with a value block", func(t *testing.T) {
+		var ids []podcast.ID
+		for p, err := range client.AllPodcastsByTag(ctx, podcast.TagValue, &podcastindex.PodcastsByTagParams{Max: 1}) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, p.ID)
+		}
+		if !slices.Equal(ids, []podcast.ID{920666}) {
+			t.Errorf("expected feed 920666, got %v", ids)
+		}
+	})
+	t.Run("bymedium and dead filter the podcasts", func(t *testing.T) {
+		music, err := client.PodcastsByMedium(ctx, podcast.MediumMusic, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dead, err := client.DeadPodcasts(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(titles(music), []string{"Podcast Index Radio"}) || !slices.Equal(titles(dead), []string{"Podcast Index Radio"}) {
+			t.Errorf("expected Podcast Index Radio, got %v and %v", titles(music), titles(dead))
+		}
+	})
+}
+
+func TestEpisodes(t *testing.T) {
+	client := NewClient(t, seededServer(t), podcastindex.NewClientOptions{})
+	ctx := context.Background()
+
+	t.Run("byfeedid returns the episodes newest first, without live episodes", func(t *testing.T) {
+		episodes, err := client.GetEpisodesByFeedID(ctx, 920666, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := episodeIDs(*episodes); !slices.Equal(got, []episode.ID{3, 2, 1}) {
+			t.Errorf("expected episodes [3 2 1], got %v", got)
+		}
+	})
+	t.Run("since returns the episodes oldest first, up to max", func(t *testing.T) {
+		episodes, err := client.GetEpisodesByFeedID(ctx, 920666, &podcastindex.GetEpisodesParams{Since: time.Unix(2000, 0), Max: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := episodeIDs(*episodes); !slices.Equal(got, []episode.ID{2}) {
+			t.Errorf("expected episodes [2], the oldest since, got %v", got)
+		}
+	})
+	t.Run("byfeedurl, byitunesid and bypodcastguid return the episodes of the podcast", func(t *testing.T) {
+		byURL, err := client.GetEpisodesByFeedURL(ctx, mustParseURL(t, "https://feeds.podcastindex.org/pc20.xml"), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		byITunesID, err := client.GetEpisodesByITunesID(ctx, "1584274529", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		byGUID, err := client.GetEpisodesByPodcastGUID(ctx, "917393e3-1b1e-5cef-ace4-edaa54e1f810", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, episodes := range []*[]podcastindex.Episode{byURL, byITunesID, byGUID} {
+			if len(*episodes) != 3 {
+				t.Errorf("expected 3 episodes, got %d", len(*episodes))
+			}
+		}
+	})
+	t.Run("byid returns the episode in full", func(t *testing.T) {
+		e, err := client.GetEpisodeByID(ctx, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.Title != "Episode 2" || len(strings.Fields(e.Description)) != 150 {
+			t.Errorf("expected Episode 2 in full, got %q with %d words", e.Title, len(strings.Fields(e.Description)))
+		}
+		_, err = client.GetEpisodeByID(ctx, 99)
+		if !errors.Is(err, podcastindex.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+	t.Run("byguid returns the episode in the scoped feed", func(t *testing.T) {
+		e, err := client.GetEpisodeByGUID(ctx, "two", podcastindex.EpisodeGUIDScope{PodcastGUID: "917393e3-1b1e-5cef-ace4-edaa54e1f810"}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.ID != 2 {
+			t.Errorf("expected episode 2, got %d", e.ID)
+		}
+		_, err = client.GetEpisodeByGUID(ctx, "two", podcastindex.EpisodeGUIDScope{FeedID: 75075}, nil)
+		if !errors.Is(err, podcastindex.ErrNotFound) {
+			t.Errorf("expected ErrNotFound in another feed, got %v", err)
+		}
+	})
+	t.Run("live returns the live episodes", func(t *testing.T) {
+		episodes, err := client.GetLiveEpisodes(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := episodeIDs(*episodes); !slices.Equal(got, []episode.ID{4}) {
+			t.Errorf("expected episodes [4], got %v", got)
+		}
+	})
+}
+
+func TestCategories(t *testing.T) {
+	client := NewClient(t, seededServer(t), podcastindex.NewClientOptions{})
+	categories, err := client.Categories(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []podcast.Category{{ID: 1, Name: "Arts"}, {ID: 2, Name: "Books"}}
+	if !slices.Equal(categories, expected) {
+		t.Errorf("expected %v, got %v", expected, categories)
+	}
+}
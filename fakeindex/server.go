@@ -0,0 +1,215 @@
+// Package fakeindex provides an in-memory fake of the PodcastIndex API, for testing code which uses the podcastindex
+// package, or any other PodcastIndex client, without network access or credentials.
+//
+// A Server holds a dataset of podcasts, episodes and categories, seeded from podcastindex.Podcast and
+// podcastindex.Episode values or JSON fixtures, and serves the search, podcasts/by*, episodes/by*, episodes/live and
+// categories/list endpoints from it. Like the API, it honours the max, fulltext and clean parameters, and checks the
+// SHA-1 Authorization header of every request. Faults, such as latency, errors and 429 Too Many Requests, can be
+// injected to test how clients handle them.
+//
+//	server := fakeindex.NewServer()
+//	server.AddPodcasts(podcast)
+//	client := fakeindex.NewClient(t, server, podcastindex.NewClientOptions{})
+//
+// The fakeindex command serves a Server over HTTP, for clients not written in Go.
+package fakeindex
+
+import (
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// BasePath is the path the API is served under, as it is by the PodcastIndex API.
+const BasePath = "/api/1.0"
+
+// AuthWindow is how far the X-Auth-Date of a request may be from the Server's clock before the request is rejected.
+const AuthWindow = 3 * time.Minute
+
+// Server is an in-memory fake of the PodcastIndex API; it is an http.Handler, safe for concurrent use.
+//
+// The zero value is not usable; create a Server with NewServer.
+type Server struct {
+	// Now (Optional) tells the time the X-Auth-Date of requests is checked against; defaults to time.Now.
+	Now func() time.Time
+
+	mu          sync.RWMutex
+	credentials map[string]string
+	podcasts    []podcastindex.Podcast
+	episodes    []podcastindex.Episode
+	categories  []podcast.Category
+	faults      []*Fault
+	routes      map[string]http.HandlerFunc
+}
+
+// NewServer creates a Server with an empty dataset, which accepts no credentials until AddCredentials is called.
+func NewServer() *Server {
+	s := &Server{credentials: map[string]string{}}
+	s.routes = map[string]http.HandlerFunc{
+		"/search/byterm":          s.searchByTerm,
+		"/search/bytitle":         s.searchByTitle,
+		"/search/byperson":        s.searchByPerson,
+		"/search/music/byterm":    s.searchMusicByTerm,
+		"/podcasts/byfeedid":      s.podcastByFeedID,
+		"/podcasts/byfeedurl":     s.podcastByFeedURL,
+		"/podcasts/byguid":        s.podcastByGUID,
+		"/podcasts/byitunesid":    s.podcastByITunesID,
+		"/podcasts/bytag":         s.podcastsByTag,
+		"/podcasts/bymedium":      s.podcastsByMedium,
+		"/podcasts/dead":          s.podcastsDead,
+		"/episodes/byfeedid":      s.episodesByFeedID,
+		"/episodes/byfeedurl":     s.episodesByFeedURL,
+		"/episodes/byitunesid":    s.episodesByITunesID,
+		"/episodes/bypodcastguid": s.episodesByPodcastGUID,
+		"/episodes/byid":          s.episodeByID,
+		"/episodes/byguid":        s.episodeByGUID,
+		"/episodes/live":          s.episodesLive,
+		"/categories/list":        s.categoriesList,
+	}
+	return s
+}
+
+// AddCredentials allows requests signed with the API key and secret.
+func (s *Server) AddCredentials(key, secret string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.credentials[key] = secret
+}
+
+// AddPodcasts adds podcasts to the dataset, replacing any podcast with the same ID.
+func (s *Server) AddPodcasts(podcasts ...podcastindex.Podcast) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range podcasts {
+		s.podcasts = replaceOrAppend(s.podcasts, p, func(existing podcastindex.Podcast) bool { return existing.ID == p.ID })
+	}
+}
+
+// AddEpisodes adds episodes to the dataset, replacing any episode with the same ID.
+//
+// Episodes belong to the podcast with their FeedID, which does not need to be added first.
+func (s *Server) AddEpisodes(episodes ...podcastindex.Episode) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range episodes {
+		s.episodes = replaceOrAppend(s.episodes, e, func(existing podcastindex.Episode) bool { return existing.ID == e.ID })
+	}
+}
+
+// AddCategories adds categories to the dataset, replacing any category with the same ID.
+func (s *Server) AddCategories(categories ...podcast.Category) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range categories {
+		s.categories = replaceOrAppend(s.categories, c, func(existing podcast.Category) bool { return existing.ID == c.ID })
+	}
+}
+
+// Reset removes every podcast, episode, category and fault, keeping the credentials.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.podcasts = nil
+	s.episodes = nil
+	s.categories = nil
+	s.faults = nil
+}
+
+func replaceOrAppend[T any](items []T, item T, same func(T) bool) []T {
+	for i := range items {
+		if same(items[i]) {
+			items[i] = item
+			return items
+		}
+	}
+	return append(items, item)
+}
+
+// ServeHTTP serves a request to the fake API, or to the admin endpoints under AdminPath.
+//
+// Injected faults are applied first, then the request's authentication is checked, before it is routed to the
+// endpoint. Endpoints are served under BasePath, and also relative to the root.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, AdminPath) {
+		s.serveAdmin(w, r)
+		return
+	}
+	// report the Server's clock, which clients may use to correct for clock skew when authentication fails.
+	w.Header().Set("Date", s.now().UTC().Format(http.TimeFormat))
+	endpoint := strings.TrimPrefix(r.URL.Path, BasePath)
+	if !s.applyFaults(w, r, endpoint) {
+		return
+	}
+	if description, ok := s.authenticate(r); !ok {
+		writeError(w, http.StatusUnauthorized, description)
+		return
+	}
+	route, ok := s.routes[endpoint]
+	if !ok || r.Method != http.MethodGet {
+		writeError(w, http.StatusNotFound, "Endpoint not found.")
+		return
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	route(w, r)
+}
+
+// authenticate checks the User-Agent, X-Auth-Key, X-Auth-Date and Authorization headers of a request, as the API does.
+//
+// Returns: the description of the problem, and false, if the request is not authenticated
+func (s *Server) authenticate(r *http.Request) (string, bool) {
+	if r.Header.Get("User-Agent") == "" {
+		return "A User-Agent header is required.", false
+	}
+	key := r.Header.Get("X-Auth-Key")
+	s.mu.RLock()
+	secret, ok := s.credentials[key]
+	s.mu.RUnlock()
+	if !ok {
+		return "Authorization header doesn't match the X-Auth-Key provided.", false
+	}
+	date := r.Header.Get("X-Auth-Date")
+	unixTime, err := strconv.ParseInt(date, 10, 64)
+	if err != nil {
+		return "X-Auth-Date must be a unix epoch time.", false
+	}
+	skew := s.now().Sub(time.Unix(unixTime, 0))
+	if skew > AuthWindow || skew < -AuthWindow {
+		return "X-Auth-Date is too far from the server time.", false
+	}
+	hash := sha1.Sum([]byte(key + secret + date))
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(hex.EncodeToString(hash[:]))) != 1 {
+		return "Authorization header doesn't match the X-Auth-Key provided.", false
+	}
+	return "", true
+}
+
+func (s *Server) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
+
+// writeJSON writes a 200/OK response with body encoded as JSON.
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	// errors writing the response are the client's problem, and cannot be reported to it.
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes an error response in the API's format: "status": "false", with a description of the error.
+func writeError(w http.ResponseWriter, statusCode int, description string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]string{"status": "false", "description": description})
+}
@@ -0,0 +1,100 @@
+package fakeindex
+
+import (
+	"context"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex"
+)
+
+// signedRequest returns a request to categories/list, signed with key and secret at signedAt.
+func signedRequest(key, secret string, signedAt time.Time) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, BasePath+"/categories/list", nil)
+	date := strconv.FormatInt(signedAt.Unix(), 10)
+	req.Header.Set("User-Agent", "fakeindex/test")
+	req.Header.Set("X-Auth-Key", key)
+	req.Header.Set("X-Auth-Date", date)
+	req.Header.Set("Authorization", fmt.Sprintf("%x", sha1.Sum([]byte(key+secret+date))))
+	return req
+}
+
+func TestServerAuthentication(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	server := NewServer()
+	server.Now = func() time.Time { return now }
+	server.AddCredentials("key", "secret")
+
+	tests := []struct {
+		name     string
+		request  *http.Request
+		expected int
+	}{
+		{"a correctly signed request is accepted", signedRequest("key", "secret", now), http.StatusOK},
+		{"a request signed within the window is accepted", signedRequest("key", "secret", now.Add(-2*time.Minute)), http.StatusOK},
+		{"an unknown key is rejected", signedRequest("other", "secret", now), http.StatusUnauthorized},
+		{"the wrong secret is rejected", signedRequest("key", "wrong", now), http.StatusUnauthorized},
+		{"a stale date is rejected", signedRequest("key", "secret", now.Add(-AuthWindow-time.Second)), http.StatusUnauthorized},
+		{"a missing User-Agent is rejected", func() *http.Request {
+			req := signedRequest("key", "secret", now)
+			req.Header.Del("User-Agent")
+			return req
+		}(), http.StatusUnauthorized},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, test.request)
+			if recorder.Code != test.expected {
+				t.Errorf("expected status %d, got %d: %s", test.expected, recorder.Code, recorder.Body)
+			}
+		})
+	}
+}
+
+func TestServerUnknownEndpoint(t *testing.T) {
+	server := NewServer()
+	server.AddCredentials("key", "secret")
+	req := signedRequest("key", "secret", time.Now())
+	req.URL.Path = BasePath + "/unknown"
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, req)
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", recorder.Code)
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	server := NewServer()
+	ctx := context.Background()
+
+	t.Run("the client is signed with the test credentials", func(t *testing.T) {
+		client := NewClient(t, server, podcastindex.NewClientOptions{})
+		if _, err := client.Categories(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("other credentials must be added to the server", func(t *testing.T) {
+		client := NewClient(t, server, podcastindex.NewClientOptions{APIKey: "other", APISecret: "secret"})
+		if _, err := client.Categories(ctx); !errors.Is(err, podcastindex.ErrUnauthorized) {
+			t.Fatalf("expected ErrUnauthorized, got %v", err)
+		}
+		server.AddCredentials("other", "secret")
+		if _, err := client.Categories(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+	t.Run("a skewed client clock is corrected from the Date header", func(t *testing.T) {
+		skewed := podcastindex.ClockFunc(func() time.Time { return time.Now().Add(time.Hour) })
+		client := NewClient(t, server, podcastindex.NewClientOptions{Clock: skewed})
+		if _, err := client.Categories(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
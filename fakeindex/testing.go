@@ -0,0 +1,49 @@
+package fakeindex
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/jjgmckenzie/podcastindex"
+)
+
+// Test credentials are the API key and secret NewClient signs requests with, unless its options set credentials.
+const (
+	TestAPIKey    = "FAKEINDEXTESTKEY"
+	TestAPISecret = "fakeindex-test-secret"
+)
+
+// NewTestServer starts an httptest.Server serving server for a test, which is closed when the test finishes.
+func NewTestServer(t testing.TB, server *Server) *httptest.Server {
+	t.Helper()
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	return httpServer
+}
+
+// NewClient creates a podcastindex.Client for a test, sending its requests to server, which is started with
+// NewTestServer.
+//
+// Unless options sets an API key or credentials, the client is signed with TestAPIKey and TestAPISecret, which are
+// added to server; otherwise, add the credentials to server with Server.AddCredentials. The user agent defaults to
+// podcastindex-go/fakeindex.
+func NewClient(t testing.TB, server *Server, options podcastindex.NewClientOptions) *podcastindex.Client {
+	t.Helper()
+	httpServer := NewTestServer(t, server)
+	baseURL, err := url.Parse(httpServer.URL + BasePath + "/")
+	if err != nil {
+		t.Fatalf("fakeindex: %v", err)
+	}
+	options.BaseURL = baseURL
+	options.HTTPClient = httpServer.Client()
+	if options.UserAgent == "" {
+		options.UserAgent = "podcastindex-go/fakeindex"
+	}
+	if options.APIKey == "" && options.Credentials == nil {
+		server.AddCredentials(TestAPIKey, TestAPISecret)
+		options.APIKey = TestAPIKey
+		options.APISecret = TestAPISecret
+	}
+	return podcastindex.NewClient(options)
+}
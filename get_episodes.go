@@ -4,26 +4,41 @@ import (
 	"context"
 	"net/url"
 	"strconv"
+	"time"
 )
 
+// GetEpisodesParams is a struct that contains the optional parameters for the GetEpisodes and GetEpisodesBy* methods.
+//
+// The optional parameters are:
 type GetEpisodesParams struct {
 	// Max is the maximum number of episodes to return
 	Max int
+	// Since : If present, only episodes published since this time will be returned.
+	Since time.Time
 	//If present, return the full text value of any text fields (ex: description). If not provided, field value is truncated to 100 words.
 	FullText bool
 }
 
+// encode adds the parameters to urlParams in the format expected by the API.
+func (params *GetEpisodesParams) encode(urlParams url.Values) {
+	if params == nil {
+		return
+	}
+	if params.Max != 0 {
+		urlParams.Set("max", strconv.Itoa(params.Max))
+	}
+	if !params.Since.IsZero() {
+		urlParams.Set("since", unixTime(params.Since))
+	}
+	if params.FullText {
+		urlParams.Add("fulltext", "")
+	}
+}
+
 func (c *Client) GetEpisodes(ctx context.Context, podcast Podcast, params *GetEpisodesParams) (*[]Episode, error) {
 	var response getEpisodeResponse
 	urlParams := url.Values{"id": {strconv.Itoa(int(podcast.ID))}}
-	if params != nil {
-		if params.Max != 0 {
-			urlParams.Set("max", strconv.Itoa(params.Max))
-		}
-		if params.FullText {
-			urlParams.Add("fulltext", "")
-		}
-	}
+	params.encode(urlParams)
 	err := c.api.Get(ctx, "/episodes/byfeedid", urlParams, &response)
 	if err != nil {
 		return nil, err
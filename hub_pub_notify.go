@@ -0,0 +1,56 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// NotifyFeedChangedParams identifies the feed which has changed for the NotifyFeedChanged method.
+//
+// One of FeedID or FeedURL must be set.
+type NotifyFeedChangedParams struct {
+	// FeedID is the internal PodcastIndex.org Feed ID of the feed that changed.
+	FeedID podcast.ID
+	// FeedURL is the url of the feed that changed.
+	FeedURL *url.URL
+}
+
+// NotifyFeedChangedResult is the result of notifying the index that a feed has changed.
+type NotifyFeedChangedResult struct {
+	// Description is the description of the response
+	Description string
+}
+
+// notifyFeedChangedResponse is the response from the hub/pubnotify endpoint on a 200/OK response.
+type notifyFeedChangedResponse struct {
+	// Status indicates API request status; either "true" or "false"
+	Status string `json:"status"`
+	//  Description is the description of the response
+	Description string `json:"description"`
+}
+
+// NotifyFeedChanged notifies the index that a feed has changed, so it is marked for immediate update.
+//
+// The API key must have write or publisher permissions; if it does not, the returned error wraps ErrWritePermission.
+func (c *Client) NotifyFeedChanged(ctx context.Context, params NotifyFeedChangedParams) (*NotifyFeedChangedResult, error) {
+	var response notifyFeedChangedResponse
+	urlParams := url.Values{}
+	if params.FeedID != 0 {
+		urlParams.Set("id", strconv.Itoa(int(params.FeedID)))
+	}
+	if params.FeedURL != nil {
+		urlParams.Set("url", params.FeedURL.String())
+	}
+	if len(urlParams) == 0 {
+		return nil, errors.New("NotifyFeedChanged requires either a FeedID or a FeedURL")
+	}
+	err := c.api.Get(ctx, "/hub/pubnotify", urlParams, &response)
+	if err != nil {
+		return nil, publisherError(err)
+	}
+	return &NotifyFeedChangedResult{Description: response.Description}, nil
+}
@@ -0,0 +1,62 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestNotifyFeedChangedRequest(t *testing.T) {
+	t.Run("the client sends the feed ID", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		_, _ = client.NotifyFeedChanged(context.Background(), NotifyFeedChangedParams{FeedID: testValidFeedID})
+		if err := searchServer.ExpectPathAndQuery("/hub/pubnotify", url.Values{"id": {"75075"}}); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client sends the feed URL", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		_, _ = client.NotifyFeedChanged(context.Background(), NotifyFeedChangedParams{FeedURL: &testAddFeedURL})
+		if err := searchServer.ExpectPathAndQuery("/hub/pubnotify", url.Values{"url": {testAddFeedURL.String()}}); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client requires a feed ID or URL", func(t *testing.T) {
+		_, err := unauthenticatedClient().NotifyFeedChanged(context.Background(), NotifyFeedChangedParams{})
+		if err == nil {
+			t.Errorf("expected an error when neither FeedID nor FeedURL is set")
+		}
+	})
+	t.Run("the client returns the description", func(t *testing.T) {
+		client := GetJSONServer(t, `{"status": "true", "description": "Feed marked for immediate update."}`)
+		result, err := client.NotifyFeedChanged(context.Background(), NotifyFeedChangedParams{FeedID: testValidFeedID})
+		if err != nil {
+			t.Fatalf("NotifyFeedChanged failed: %v", err)
+		}
+		if result.Description != "Feed marked for immediate update." {
+			t.Errorf("unexpected result: %+v", result)
+		}
+	})
+	t.Run("a read-only key returns ErrWritePermission", func(t *testing.T) {
+		_, err := GetStatusServer(t, http.StatusForbidden).NotifyFeedChanged(context.Background(), NotifyFeedChangedParams{FeedID: testValidFeedID})
+		if !errors.Is(err, ErrWritePermission) {
+			t.Errorf("expected ErrWritePermission, got %v", err)
+		}
+	})
+}
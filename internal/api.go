@@ -1,14 +1,18 @@
 package internal
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha1"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 )
 
@@ -20,32 +24,34 @@ type PodcastIndexAPI struct {
 	UserAgent  string
 	BaseURL    *url.URL
 	HTTPClient *http.Client
-}
-
-// doRequest performs the common logic for making a GET request.
-// It constructs the URL, calls getRequest, and returns the response.
-// The caller is responsible for closing the response body.
-func (api *PodcastIndexAPI) doRequest(ctx context.Context, endpoint string, params url.Values) (*http.Response, error) {
-	if api.HTTPClient == nil {
-		return nil, fmt.Errorf("HTTPClient is nil, please set a valid HTTPClient")
-	}
-	requestURL := api.BaseURL.JoinPath(endpoint)
-	requestURL.RawQuery = params.Encode()
-
-	resp, err := api.getRequest(ctx, *requestURL, http.MethodGet, nil)
-	if err != nil {
-		// Wrap the error for better context
-		return nil, fmt.Errorf("failed to execute HTTP request: %w", err)
-	}
-	return resp, nil
+	// RetryPolicy (Optional) is the policy used to retry failed requests; nil means requests are not retried.
+	RetryPolicy *RetryPolicy
+	// RateLimiter (Optional) limits the rate of requests, including retries; nil means requests are not limited.
+	RateLimiter *RateLimiter
+	// Cache (Optional) caches the responses of GET requests; nil means responses are not cached.
+	Cache Cache
+	// CacheTTL returns how long the responses of an endpoint are cached for; zero means they are not cached.
+	CacheTTL func(endpoint string) time.Duration
+	// CoalesceRequests (Optional) makes identical concurrent GET requests share a single round trip.
+	CoalesceRequests bool
+	// flights are the requests in flight, when CoalesceRequests is set.
+	flights flightGroup
+	// Logger (Optional) receives diagnostics, e.g. retries; nil means diagnostics are discarded.
+	Logger *slog.Logger
+	// Middlewares (Optional) wrap every request, outermost first, outside of the cache, retries and rate limiter.
+	Middlewares []Middleware
+	// Credentials (Optional) provides the API key and secret each request is signed with, in place of APIKey and APISecret.
+	Credentials CredentialsProvider
+	// Clock (Optional) tells the time requests are signed at; defaults to the system clock.
+	Clock Clock
+	// clockOffset is the clock skew, in nanoseconds, detected between the Clock and the API, added to signing times.
+	clockOffset atomic.Int64
 }
 
 // Get makes a GET request to the PodcastIndex API
 //
 // used internally by the PodcastIndex API Client
 //
-// IMPORTANT: Remember to close the response body.
-//
 // context: The context to use for the request
 // endpoint: The endpoint to make the request to
 // params: The query parameters to include in the request
@@ -53,72 +59,227 @@ func (api *PodcastIndexAPI) doRequest(ctx context.Context, endpoint string, para
 //
 // Returns: error if the request fails, or the response body is not valid JSON
 func (api *PodcastIndexAPI) Get(ctx context.Context, endpoint string, params url.Values, result any) error {
-	resp, err := api.doRequest(ctx, endpoint, params)
+	return api.request(ctx, http.MethodGet, endpoint, api.BaseURL.JoinPath(endpoint), params, nil, result)
+}
+
+// GetRoot makes a GET request to a path relative to the root of the PodcastIndex API, rather than BaseURL.
+//
+// used internally by the PodcastIndex API Client for the endpoints which live outside of /api/1.0/,
+// e.g. the Apple replacement endpoints and static data. The root is BaseURL without its trailing /api/1.0, so a
+// BaseURL with a path prefix, e.g. https://example.com/mock/api/1.0/, keeps it: https://example.com/mock/
+//
+// Returns: error if the request fails, or the response body is not valid JSON
+func (api *PodcastIndexAPI) GetRoot(ctx context.Context, path string, params url.Values, result any) error {
+	root := *api.BaseURL
+	root.Path = strings.TrimSuffix("/"+strings.Trim(root.Path, "/"), apiVersionPath)
+	root.RawPath = ""
+	return api.request(ctx, http.MethodGet, path, root.JoinPath(path), params, nil, result)
+}
+
+// apiVersionPath is the path of the versioned API under the root of the PodcastIndex API.
+const apiVersionPath = "/api/1.0"
+
+// Post makes a POST request to the PodcastIndex API, sending body encoded as JSON
+//
+// used internally by the PodcastIndex API Client
+//
+// context: The context to use for the request
+// endpoint: The endpoint to make the request to
+// params: The query parameters to include in the request
+// body: The value to encode as the JSON request body
+// result: The struct to unmarshal the response into
+//
+// Returns: error if the body cannot be encoded, the request fails, or the response body is not valid JSON
+func (api *PodcastIndexAPI) Post(ctx context.Context, endpoint string, params url.Values, body any, result any) error {
+	encodedBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode request body for podcast index API: %w", err)
+	}
+	return api.request(ctx, http.MethodPost, endpoint, api.BaseURL.JoinPath(endpoint), params, encodedBody, result)
+}
+
+// request makes a request to the PodcastIndex API through the middleware chain, and decodes the response into result.
+//
+// Returns: an *APIError if the API responds with an error, or an error wrapping ErrDecode if the response cannot be decoded
+func (api *PodcastIndexAPI) request(ctx context.Context, method string, endpoint string, requestURL *url.URL, params url.Values, body []byte, result any) error {
+	if params == nil {
+		params = url.Values{}
+	}
+	exchange := &Exchange{
+		Method:   method,
+		Endpoint: endpoint,
+		URL:      requestURL,
+		Params:   params,
+		Body:     body,
+		Header:   http.Header{},
+		Logger:   RequestLogger(api.Logger, method, endpoint),
+	}
+	started := time.Now()
+	err := api.handler()(ctx, exchange)
+	collectMetadata(ctx, exchange, time.Since(started))
 	if err != nil {
-		// Error from doRequest already includes URL and context
 		return err
 	}
+	if err := json.Unmarshal(exchange.ResponseBody, result); err != nil {
+		return fmt.Errorf("%w from podcast index API (%s): %w", ErrDecode, exchange.requestURL(), err)
+	}
+	return nil
+}
+
+// handler returns the Handler which sends requests through the Middlewares, then the cache, request coalescing,
+// retries and rate limiter, where configured, to roundTrip.
+func (api *PodcastIndexAPI) handler() Handler {
+	var middlewares []Middleware
+	middlewares = append(middlewares, api.Middlewares...)
+	if api.Cache != nil && api.CacheTTL != nil {
+		middlewares = append(middlewares, CacheMiddleware(api.Cache, api.CacheTTL))
+	}
+	if api.CoalesceRequests {
+		middlewares = append(middlewares, api.flights.middleware)
+	}
+	if api.RetryPolicy != nil {
+		middlewares = append(middlewares, RetryMiddleware(api.RetryPolicy))
+	}
+	if api.RateLimiter != nil {
+		middlewares = append(middlewares, RateLimitMiddleware(api.RateLimiter))
+	}
+	return Chain(api.roundTrip, middlewares...)
+}
+
+// roundTrip is the innermost Handler: it signs and sends a single request, and reads the response.
+// If the request fails authentication because of clock skew, it is re-signed and sent once more.
+//
+// Returns: an *APIError if the API responds with an error, including a 200/OK response reporting "status": "false"
+func (api *PodcastIndexAPI) roundTrip(ctx context.Context, exchange *Exchange) error {
+	err := api.send(ctx, exchange)
+	return api.correctClockSkew(ctx, exchange, err)
+}
+
+// send signs and sends a single request, and reads the response.
+func (api *PodcastIndexAPI) send(ctx context.Context, exchange *Exchange) error {
+	if api.HTTPClient == nil {
+		return fmt.Errorf("HTTPClient is nil, please set a valid HTTPClient")
+	}
+	req, err := api.newRequest(ctx, exchange)
+	if err != nil {
+		return fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+	exchange.Request = req
+	exchange.Response = nil
+	exchange.ResponseBody = nil
+	exchange.Sent = time.Now()
+	resp, err := api.HTTPClient.Do(req)
+	exchange.Elapsed = time.Since(exchange.Sent)
+	if err != nil {
+		exchange.logger().DebugContext(ctx, "podcast index API request failed", "elapsed", exchange.Elapsed, "error", err)
+		// Wrap the error for better context
+		return fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
 	defer func(body io.ReadCloser) {
 		// ignore errors closing the body; we do not care about them once we have read the response body.
 		_ = body.Close()
 	}(resp.Body)
+	exchange.Response = resp
+	exchange.logger().DebugContext(ctx, "podcast index API request", "status", resp.StatusCode, "elapsed", exchange.Elapsed)
 
 	statusIsOK := resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusFound
 
 	if !statusIsOK {
-		requestURLStr := resp.Request.URL.String() // Get URL from the actual request in the response
-		if resp.StatusCode == http.StatusUnauthorized {
-			return fmt.Errorf("authentication error when making request to podcast index API (%s), please verify your API key and API secret values are correct", requestURLStr)
-		}
-		if resp.StatusCode == http.StatusBadRequest {
-			return fmt.Errorf("podcast index API at %s returned status code %d. This usually indicates a malformed request, potentially a bug in this client or an API change. Please file an issue at https://github.com/jjgmckenzie/podcast-index/issues with steps to reproduce the error",
-				requestURLStr, resp.StatusCode)
-		}
 		bodyBytes, readErr := io.ReadAll(resp.Body)
 		if readErr != nil {
-			return fmt.Errorf("podcast index API at %s returned status code %d, failed to read error response: %w",
-				requestURLStr, resp.StatusCode, readErr)
+			return fmt.Errorf("%w, failed to read error response: %w", newAPIError(exchange.Endpoint, resp, nil), readErr)
 		}
-		respBody := string(bodyBytes)
-		return fmt.Errorf("podcast index API at %s returned status code %d with response: %s",
-			requestURLStr, resp.StatusCode, respBody)
+		exchange.ResponseBody = bodyBytes
+		return newAPIError(exchange.Endpoint, resp, bodyBytes)
 	}
-	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-		return fmt.Errorf("failed to decode response from podcast index API (%s): %w", resp.Request.URL.String(), err)
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response from podcast index API (%s): %w", resp.Request.URL.String(), err)
+	}
+	exchange.ResponseBody = bodyBytes
+	return checkResponse(exchange.Endpoint, resp, bodyBytes)
+}
+
+// lookupEndpoints are the endpoints which look up a single podcast, episode or value block, or the episodes of a
+// single feed, for which the API reports a missing result with "status": "false" rather than a 404.
+var lookupEndpoints = map[string]bool{
+	"podcasts/byfeedid":      true,
+	"podcasts/byfeedurl":     true,
+	"podcasts/byguid":        true,
+	"podcasts/byitunesid":    true,
+	"episodes/byfeedid":      true,
+	"episodes/byfeedurl":     true,
+	"episodes/byitunesid":    true,
+	"episodes/bypodcastguid": true,
+	"episodes/byid":          true,
+	"episodes/byguid":        true,
+	"value/byfeedid":         true,
+	"value/byitunesid":       true,
+	"value/bypodcastguid":    true,
+	"value/byepisodeguid":    true,
+}
+
+// checkResponse checks a 200/OK response body for an error reported by the API with "status": "false".
+//
+// For lookup endpoints, e.g. an unknown feed ID, the error is returned as an APIError wrapping ErrNotFound, rather than
+// decoding an empty result. Other endpoints, e.g. /add/byfeedurl, report other failures this way, so their errors
+// are returned as an APIError carrying the API's description, without a sentinel error.
+func checkResponse(endpoint string, resp *http.Response, body []byte) error {
+	var envelope struct {
+		Status any `json:"status"`
+	}
+	if json.Unmarshal(body, &envelope) == nil && (envelope.Status == "false" || envelope.Status == false) {
+		apiErr := newAPIError(endpoint, resp, body)
+		if lookupEndpoints[strings.TrimPrefix(endpoint, "/")] {
+			apiErr.kind = ErrNotFound
+		}
+		return apiErr
 	}
 	return nil
 }
 
-// getRequest creates a new HTTP request with the correct headers for the PodcastIndex API
+// newRequest creates a new HTTP request for an exchange, signed with the correct headers for the PodcastIndex API
 //
 // used internally by the PodcastIndex API Client
 //
 // context: The context to use for the request
-// url: The URL to make the request to
-// method: The HTTP method to use for the request
-// body: The body to include in the request
+// exchange: The exchange to create the request for; its Header is added to the request
 //
 // Returns: The new HTTP request, or an error if the request fails to create
-func (api *PodcastIndexAPI) getRequest(ctx context.Context, url url.URL, method string, body io.Reader) (*http.Response, error) {
-	req, err := http.NewRequestWithContext(ctx, method, url.String(), body)
+func (api *PodcastIndexAPI) newRequest(ctx context.Context, exchange *Exchange) (*http.Request, error) {
+	var body io.Reader
+	if exchange.Body != nil {
+		body = bytes.NewReader(exchange.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, exchange.Method, exchange.requestURL(), body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	api.addRequiredHeaders(req)
-	return api.HTTPClient.Do(req)
+	for key, values := range exchange.Header {
+		req.Header[key] = append([]string(nil), values...)
+	}
+	credentials, err := api.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	api.addRequiredHeaders(req, credentials)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
 }
 
-func (api *PodcastIndexAPI) addRequiredHeaders(req *http.Request) {
-	unixTime := time.Now().Unix()
+func (api *PodcastIndexAPI) addRequiredHeaders(req *http.Request, credentials Credentials) {
+	unixTime := api.signingTime().Unix()
 	req.Header.Set("User-Agent", api.UserAgent)
-	req.Header.Set("X-Auth-Key", api.APIKey)
+	req.Header.Set("X-Auth-Key", credentials.APIKey)
 	req.Header.Set("X-Auth-Date", strconv.FormatInt(unixTime, 10))
 	// A SHA-1 hash of the X-Auth-Key, the corresponding secret and the X-Auth-Date value concatenated as a string.
 	// The resulting hash should be encoded as a hexadecimal value, two digits per byte, using lower case letters
 	// for the hex digits "a" through "f".
 	hash := sha1.New()
-	hash.Write([]byte(api.APIKey + api.APISecret + strconv.FormatInt(unixTime, 10)))
+	hash.Write([]byte(credentials.APIKey + credentials.APISecret + strconv.FormatInt(unixTime, 10)))
 	authHeader := hash.Sum(nil)
 	req.Header.Set("Authorization", fmt.Sprintf("%x", authHeader))
 }
@@ -111,6 +111,11 @@ func TestGetResponseErrorHandling(t *testing.T) {
 			statusCode:     http.StatusUnauthorized,
 			expectedErrMsg: "authentication error",
 		},
+		{
+			name:           "Forbidden",
+			statusCode:     http.StatusForbidden,
+			expectedErrMsg: "not permitted",
+		},
 		{
 			name:           "BadRequest",
 			statusCode:     http.StatusBadRequest,
@@ -286,3 +291,101 @@ func TestGetResponseReadFailure(t *testing.T) {
 		t.Errorf("Expected error message to contain '%s', but got '%s'", expectedErrMsg, err.Error())
 	}
 }
+
+func TestPostRequest(t *testing.T) {
+	var capturedRequest *http.Request
+	var capturedBody []byte
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		capturedRequest = r
+		capturedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"status": "true", "description": "Success"}`))
+	}
+	api, _ := setupTestAPI(t, handler)
+
+	var result struct {
+		Status string `json:"status"`
+	}
+	err := api.Post(context.Background(), "podcasts/batch/byguid", url.Values{"pretty": {""}}, []string{"a", "b"}, &result)
+	if err != nil {
+		t.Fatalf("Post failed: %v", err)
+	}
+
+	t.Run("UsesPostMethod", func(t *testing.T) {
+		if capturedRequest.Method != http.MethodPost {
+			t.Errorf("Expected method %q, got %q", http.MethodPost, capturedRequest.Method)
+		}
+	})
+
+	t.Run("SendsJSONBody", func(t *testing.T) {
+		if string(capturedBody) != `["a","b"]` {
+			t.Errorf("Expected body %q, got %q", `["a","b"]`, string(capturedBody))
+		}
+		if contentType := capturedRequest.Header.Get("Content-Type"); contentType != "application/json" {
+			t.Errorf("Expected Content-Type %q, got %q", "application/json", contentType)
+		}
+	})
+
+	t.Run("SignsRequest", func(t *testing.T) {
+		for _, header := range []string{"User-Agent", "X-Auth-Key", "X-Auth-Date", "Authorization"} {
+			if capturedRequest.Header.Get(header) == "" {
+				t.Errorf("Expected header %s to be set", header)
+			}
+		}
+	})
+
+	t.Run("DecodesResponse", func(t *testing.T) {
+		if result.Status != "true" {
+			t.Errorf("Expected status %q, got %q", "true", result.Status)
+		}
+	})
+}
+
+func TestPostUnencodableBody(t *testing.T) {
+	api := newTestAPI()
+	err := api.Post(context.Background(), "test/endpoint", nil, make(chan int), nil)
+	if err == nil {
+		t.Fatalf("Expected an error due to unencodable body, but got nil")
+	}
+	expectedErrMsg := "failed to encode request body"
+	if !strings.Contains(err.Error(), expectedErrMsg) {
+		t.Errorf("Expected error message to contain '%s', but got '%s'", expectedErrMsg, err.Error())
+	}
+}
+
+func TestGetRootRequestURL(t *testing.T) {
+	tests := []struct {
+		basePath     string
+		expectedPath string
+	}{
+		{basePath: "/api/1.0/", expectedPath: "/search"},
+		{basePath: "/api/1.0", expectedPath: "/search"},
+		{basePath: "", expectedPath: "/search"},
+		{basePath: "/mock/api/1.0/", expectedPath: "/mock/search"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.basePath, func(t *testing.T) {
+			var capturedRequest *http.Request
+
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				capturedRequest = r
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{}`))
+			}
+			api, _ := setupTestAPI(t, handler)
+			api.BaseURL = api.BaseURL.JoinPath(tt.basePath)
+
+			var result struct{}
+			if err := api.GetRoot(context.Background(), "/search", url.Values{"term": {"batman"}}, &result); err != nil {
+				t.Fatalf("GetRoot failed: %v", err)
+			}
+			if capturedRequest.URL.Path != tt.expectedPath {
+				t.Errorf("Expected path %q, got %q", tt.expectedPath, capturedRequest.URL.Path)
+			}
+			if capturedRequest.URL.RawQuery != "term=batman" {
+				t.Errorf("Expected query %q, got %q", "term=batman", capturedRequest.URL.RawQuery)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Cache stores raw response bodies from the PodcastIndex API, so repeated requests need not be sent.
+//
+// Keys are the URL and canonical (sorted) query parameters of a request, e.g.
+// "https://api.podcastindex.org/api/1.0/podcasts/byfeedid?id=75075"; they never contain credentials. Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the response body cached for key, and whether one was found which has not expired.
+	Get(ctx context.Context, key string) ([]byte, bool)
+	// Set caches the response body for key, to expire after ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration)
+}
+
+// CacheMode controls how a single request uses the Cache; set it with WithCacheMode.
+type CacheMode int
+
+const (
+	// CacheDefault serves the request from the cache if it can, and caches the response otherwise.
+	CacheDefault CacheMode = iota
+	// CacheBypass neither serves the request from the cache, nor caches the response.
+	CacheBypass
+	// CacheRefresh always sends the request, and caches the response, replacing any cached response.
+	CacheRefresh
+)
+
+type cacheModeKey struct{}
+
+// WithCacheMode returns a copy of ctx which makes requests use the cache according to mode.
+func WithCacheMode(ctx context.Context, mode CacheMode) context.Context {
+	return context.WithValue(ctx, cacheModeKey{}, mode)
+}
+
+// cacheModeFrom returns the CacheMode set on ctx with WithCacheMode, or CacheDefault.
+func cacheModeFrom(ctx context.Context) CacheMode {
+	mode, _ := ctx.Value(cacheModeKey{}).(CacheMode)
+	return mode
+}
+
+// cacheKey returns the key a request is cached under: its URL, without any user info, and its query parameters,
+// sorted by key. The URL's scheme, host and base path keep apart the responses of clients with different BaseURLs.
+func cacheKey(exchange *Exchange) string {
+	requestURL := *exchange.URL
+	requestURL.User = nil
+	requestURL.RawQuery = exchange.Params.Encode()
+	return requestURL.String()
+}
+
+// CacheMiddleware returns a Middleware serving GET requests from cache, and caching their responses for as long as
+// ttl returns for their endpoint, according to the context's CacheMode. Only successful responses are cached.
+func CacheMiddleware(cache Cache, ttl func(endpoint string) time.Duration) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, exchange *Exchange) error {
+			if exchange.Method != http.MethodGet || ctx == nil {
+				return next(ctx, exchange)
+			}
+			endpointTTL := ttl(exchange.Endpoint)
+			mode := cacheModeFrom(ctx)
+			if endpointTTL <= 0 || mode == CacheBypass {
+				return next(ctx, exchange)
+			}
+			key := cacheKey(exchange)
+			if mode == CacheDefault {
+				if cached, ok := cache.Get(ctx, key); ok && json.Valid(cached) {
+					exchange.ResponseBody = cached
+					exchange.logger().DebugContext(ctx, "served podcast index API request from cache", "key", key)
+					return nil
+				}
+			}
+			if err := next(ctx, exchange); err != nil {
+				return err
+			}
+			cache.Set(ctx, key, exchange.ResponseBody, endpointTTL)
+			return nil
+		}
+	}
+}
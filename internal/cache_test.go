@@ -0,0 +1,167 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+// mapCache is a minimal Cache for testing, which never expires responses.
+type mapCache struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+	ttls    map[string]time.Duration
+}
+
+func newMapCache() *mapCache {
+	return &mapCache{entries: map[string][]byte{}, ttls: map[string]time.Duration{}}
+}
+
+func (cache *mapCache) Get(_ context.Context, key string) ([]byte, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	value, ok := cache.entries[key]
+	return value, ok
+}
+
+func (cache *mapCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.entries[key] = value
+	cache.ttls[key] = ttl
+}
+
+func TestCacheRequests(t *testing.T) {
+	requests := 0
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"status": "true", "count": ` + r.URL.Query().Get("id") + `}`))
+	})
+	cache := newMapCache()
+	api.Cache = cache
+	api.CacheTTL = func(endpoint string) time.Duration {
+		if endpoint == "/uncached" {
+			return 0
+		}
+		return time.Minute
+	}
+	type response struct {
+		Count int `json:"count"`
+	}
+	key := func(path string) string {
+		return api.BaseURL.String() + path
+	}
+	get := func(ctx context.Context, endpoint string, id string) response {
+		t.Helper()
+		var result response
+		if err := api.Get(ctx, endpoint, url.Values{"id": {id}}, &result); err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+		return result
+	}
+
+	t.Run("ServesRepeatedRequestsFromCache", func(t *testing.T) {
+		requests = 0
+		first, second := get(context.Background(), "/cached", "1"), get(context.Background(), "/cached", "1")
+		if requests != 1 || first.Count != 1 || second.Count != 1 {
+			t.Errorf("Expected 1 request and identical results, got %d requests, %+v and %+v", requests, first, second)
+		}
+		if cache.ttls[key("/cached?id=1")] != time.Minute {
+			t.Errorf("Expected the response to be cached under its URL and query with the endpoint's TTL, got %v", cache.ttls)
+		}
+	})
+	t.Run("KeysOnQuery", func(t *testing.T) {
+		requests = 0
+		if result := get(context.Background(), "/cached", "2"); requests != 1 || result.Count != 2 {
+			t.Errorf("Expected a different query to be requested, got %d requests and %+v", requests, result)
+		}
+	})
+	t.Run("UncachedEndpoint", func(t *testing.T) {
+		requests = 0
+		get(context.Background(), "/uncached", "1")
+		get(context.Background(), "/uncached", "1")
+		if requests != 2 {
+			t.Errorf("Expected 2 requests, got %d", requests)
+		}
+	})
+	t.Run("Bypass", func(t *testing.T) {
+		requests = 0
+		ctx := WithCacheMode(context.Background(), CacheBypass)
+		get(ctx, "/cached", "3")
+		get(ctx, "/cached", "3")
+		if _, ok := cache.entries[key("/cached?id=3")]; requests != 2 || ok {
+			t.Errorf("Expected 2 requests and nothing cached, got %d requests and %v", requests, cache.entries)
+		}
+	})
+	t.Run("Refresh", func(t *testing.T) {
+		requests = 0
+		cache.entries[key("/cached?id=4")] = []byte(`{"count": 400}`)
+		if result := get(WithCacheMode(context.Background(), CacheRefresh), "/cached", "4"); requests != 1 || result.Count != 4 {
+			t.Errorf("Expected the request to be sent, got %d requests and %+v", requests, result)
+		}
+		if result := get(context.Background(), "/cached", "4"); requests != 1 || result.Count != 4 {
+			t.Errorf("Expected the refreshed response to be cached, got %d requests and %+v", requests, result)
+		}
+	})
+	t.Run("CorruptEntryIsAMiss", func(t *testing.T) {
+		requests = 0
+		cache.entries[key("/cached?id=5")] = []byte(`not json`)
+		if result := get(context.Background(), "/cached", "5"); requests != 1 || result.Count != 5 {
+			t.Errorf("Expected the request to be sent, got %d requests and %+v", requests, result)
+		}
+	})
+}
+
+func TestCacheSkipsErrorsAndPosts(t *testing.T) {
+	requests := 0
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+	cache := newMapCache()
+	api.Cache = cache
+	api.CacheTTL = func(string) time.Duration { return time.Minute }
+
+	var result struct{}
+	_ = api.Get(context.Background(), "/error", nil, &result)
+	_ = api.Post(context.Background(), "/post", nil, []string{}, &result)
+	_ = api.Post(context.Background(), "/post", nil, []string{}, &result)
+	if len(cache.entries) != 0 || requests != 3 {
+		t.Errorf("Expected errors and POST requests not to be cached, got %d requests and %v", requests, cache.entries)
+	}
+}
+
+func TestCacheKeysOnBaseURL(t *testing.T) {
+	cache := newMapCache()
+	newAPI := func(count string) *PodcastIndexAPI {
+		api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"count": ` + count + `}`))
+		})
+		api.Cache = cache
+		api.CacheTTL = func(string) time.Duration { return time.Minute }
+		return api
+	}
+	first, second := newAPI("1"), newAPI("2")
+
+	var firstResult, secondResult struct {
+		Count int `json:"count"`
+	}
+	if err := first.Get(context.Background(), "/categories/list", nil, &firstResult); err != nil {
+		t.Fatal(err)
+	}
+	if err := second.Get(context.Background(), "/categories/list", nil, &secondResult); err != nil {
+		t.Fatal(err)
+	}
+	if firstResult.Count != 1 || secondResult.Count != 2 {
+		t.Errorf("Expected each BaseURL's own response from a shared cache, got %d and %d", firstResult.Count, secondResult.Count)
+	}
+	if len(cache.entries) != 2 {
+		t.Errorf("Expected a cache entry per BaseURL, got %v", cache.entries)
+	}
+}
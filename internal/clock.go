@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// MaxClockSkew is how far the time a request was signed at may be from the API's clock, as reported by its Date
+// header, before an authentication failure is put down to clock skew. The API rejects requests whose X-Auth-Date
+// is more than a few minutes from its own clock.
+const MaxClockSkew = time.Minute
+
+// Clock tells the time requests are signed at.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+}
+
+// ClockFunc is a Clock calling a function, e.g. time.Now or a fake clock in tests.
+type ClockFunc func() time.Time
+
+// Now returns the current time, as told by the function.
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// signingTime returns the time to sign a request at: the Clock's time, corrected by any clock skew detected.
+func (api *PodcastIndexAPI) signingTime() time.Time {
+	now := time.Now
+	if api.Clock != nil {
+		now = api.Clock.Now
+	}
+	return now().Add(time.Duration(api.clockOffset.Load()))
+}
+
+// detectClockSkew checks whether a request failed authentication because it was signed at a time too far from the
+// API's clock, as reported by the Date header of the response.
+//
+// Returns: the offset to add to the Clock's time to match the API's clock, and whether skew was detected
+func (api *PodcastIndexAPI) detectClockSkew(exchange *Exchange, err error) (time.Duration, bool) {
+	if !errors.Is(err, ErrUnauthorized) || exchange.Response == nil || exchange.Request == nil {
+		return 0, false
+	}
+	serverTime, dateErr := http.ParseTime(exchange.Response.Header.Get("Date"))
+	if dateErr != nil {
+		return 0, false
+	}
+	signedAt, parseErr := strconv.ParseInt(exchange.Request.Header.Get("X-Auth-Date"), 10, 64)
+	if parseErr != nil {
+		return 0, false
+	}
+	skew := serverTime.Sub(time.Unix(signedAt, 0))
+	if skew.Abs() <= MaxClockSkew {
+		return 0, false
+	}
+	return time.Duration(api.clockOffset.Load()) + skew, true
+}
+
+// correctClockSkew re-sends a request which failed authentication because of clock skew, once, signed at the API's
+// time, and keeps the correction for later requests. The skew detected is logged.
+//
+// Returns: the error of the re-sent request, or err if no skew was detected
+func (api *PodcastIndexAPI) correctClockSkew(ctx context.Context, exchange *Exchange, err error) error {
+	offset, skewed := api.detectClockSkew(exchange, err)
+	if !skewed {
+		return err
+	}
+	exchange.logger().WarnContext(ctx, "clock skew detected, re-signing request with the podcast index API's time",
+		"skew", offset-time.Duration(api.clockOffset.Load()), "offset", offset)
+	api.clockOffset.Store(int64(offset))
+	return api.send(ctx, exchange)
+}
@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// setupSkewTestAPI creates a PodcastIndexAPI for a test server which, like the PodcastIndex API, rejects requests
+// signed more than 3 minutes from its clock, serverTime.
+func setupSkewTestAPI(t *testing.T, serverTime time.Time) (*PodcastIndexAPI, *int) {
+	t.Helper()
+	requests := 0
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Date", serverTime.UTC().Format(http.TimeFormat))
+		signedAt, _ := strconv.ParseInt(r.Header.Get("X-Auth-Date"), 10, 64)
+		if serverTime.Sub(time.Unix(signedAt, 0)).Abs() > 3*time.Minute {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+	return api, &requests
+}
+
+func TestClockSignsRequests(t *testing.T) {
+	var capturedRequest *http.Request
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		capturedRequest = r
+		_, _ = w.Write([]byte(`{}`))
+	})
+	api.Clock = ClockFunc(func() time.Time { return time.Unix(1700000000, 0) })
+
+	var result struct{}
+	if err := api.Get(context.Background(), "/test", nil, &result); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if date := capturedRequest.Header.Get("X-Auth-Date"); date != "1700000000" {
+		t.Errorf("Expected X-Auth-Date %q, got %q", "1700000000", date)
+	}
+	expected := fmt.Sprintf("%x", sha1.Sum([]byte("testKeytestSecret1700000000")))
+	if authorization := capturedRequest.Header.Get("Authorization"); authorization != expected {
+		t.Errorf("Expected Authorization %q, got %q", expected, authorization)
+	}
+}
+
+func TestClockSkewCorrection(t *testing.T) {
+	serverTime := time.Now().Truncate(time.Second)
+	api, requests := setupSkewTestAPI(t, serverTime)
+	api.Clock = ClockFunc(func() time.Time { return serverTime.Add(-time.Hour) })
+	var logs bytes.Buffer
+	api.Logger = slog.New(slog.NewTextHandler(&logs, nil))
+
+	var result struct{}
+	if err := api.Get(context.Background(), "/test", nil, &result); err != nil {
+		t.Fatalf("Expected the re-signed request to succeed, got %v", err)
+	}
+	if *requests != 2 {
+		t.Errorf("Expected the request to be re-sent once, got %d requests", *requests)
+	}
+	if !strings.Contains(logs.String(), "clock skew detected") || !strings.Contains(logs.String(), "skew=1h0m0s") {
+		t.Errorf("Expected the skew to be logged, got %s", logs.String())
+	}
+
+	t.Run("KeepsTheCorrection", func(t *testing.T) {
+		*requests = 0
+		if err := api.Get(context.Background(), "/test", nil, &result); err != nil {
+			t.Fatalf("Expected the request to succeed, got %v", err)
+		}
+		if *requests != 1 {
+			t.Errorf("Expected the request to be signed correctly first time, got %d requests", *requests)
+		}
+	})
+}
+
+func TestClockSkewNotDetected(t *testing.T) {
+	t.Run("NoDateHeader", func(t *testing.T) {
+		requests := 0
+		api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header()["Date"] = nil
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+		api.Clock = ClockFunc(func() time.Time { return time.Unix(0, 0) })
+		if err := api.Get(context.Background(), "/test", nil, nil); !errors.Is(err, ErrUnauthorized) || requests != 1 {
+			t.Errorf("Expected a single unauthorized request, got %d requests and %v", requests, err)
+		}
+	})
+	t.Run("ClockInSync", func(t *testing.T) {
+		requests := 0
+		api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+		if err := api.Get(context.Background(), "/test", nil, nil); !errors.Is(err, ErrUnauthorized) || requests != 1 {
+			t.Errorf("Expected a single unauthorized request, got %d requests and %v", requests, err)
+		}
+	})
+	t.Run("ReSignsOnlyOnce", func(t *testing.T) {
+		requests := 0
+		api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header().Set("Date", time.Now().Add(time.Duration(requests)*time.Hour).UTC().Format(http.TimeFormat))
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+		if err := api.Get(context.Background(), "/test", nil, nil); !errors.Is(err, ErrUnauthorized) || requests != 2 {
+			t.Errorf("Expected the request to be re-signed once, got %d requests and %v", requests, err)
+		}
+	})
+}
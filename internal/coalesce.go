@@ -0,0 +1,153 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// flight is a request in flight, shared by every caller making an identical request while it is.
+type flight struct {
+	// done is closed once the request completes, after which exchange and err are set.
+	done     chan struct{}
+	exchange Exchange
+	err      error
+	// callers is the number of callers still waiting for the request.
+	callers int
+	// cancel cancels the request, once no callers are waiting for it.
+	cancel context.CancelFunc
+}
+
+// flightGroup coalesces identical concurrent requests into a single request. The zero value is ready to use.
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// CoalesceMiddleware returns a Middleware coalescing identical GET requests made concurrently, i.e. with the same
+// URL, params and extra headers, so they share a single round trip, made by the handlers after it.
+//
+// Each caller gets its own copy of the shared request, response, response body and error, so changes to one caller's
+// result never affect another's. The shared request is made with the exchange of the caller which started it, so
+// its Logger, and the values of its context, e.g. for tracing, are used for every caller. It is independent of the
+// callers' contexts otherwise: a caller whose context ends stops waiting for it, and it is only cancelled once every
+// caller has stopped waiting.
+func CoalesceMiddleware() Middleware {
+	return (&flightGroup{}).middleware
+}
+
+func (group *flightGroup) middleware(next Handler) Handler {
+	return func(ctx context.Context, exchange *Exchange) error {
+		if exchange.Method != http.MethodGet || ctx == nil {
+			return next(ctx, exchange)
+		}
+		key := flightKey(exchange)
+		group.mu.Lock()
+		if group.flights == nil {
+			group.flights = map[string]*flight{}
+		}
+		current, ok := group.flights[key]
+		if ok {
+			exchange.logger().DebugContext(ctx, "coalesced podcast index API request with one in flight")
+		} else {
+			current = group.start(ctx, key, next, *exchange)
+		}
+		current.callers++
+		group.mu.Unlock()
+
+		select {
+		case <-current.done:
+			shared := current.exchange
+			if shared.Request != nil {
+				exchange.Request = shared.Request.Clone(ctx)
+			}
+			if shared.Response != nil {
+				response := *shared.Response
+				response.Header = response.Header.Clone()
+				response.Trailer = response.Trailer.Clone()
+				response.Request = exchange.Request
+				exchange.Response = &response
+			}
+			exchange.ResponseBody = bytes.Clone(shared.ResponseBody)
+			exchange.Sent = shared.Sent
+			exchange.Elapsed = shared.Elapsed
+			return copyError(current.err)
+		case <-ctx.Done():
+			group.mu.Lock()
+			defer group.mu.Unlock()
+			current.callers--
+			if current.callers == 0 {
+				current.cancel()
+				group.forget(key, current)
+			}
+			return fmt.Errorf("stopped waiting for podcast index API request: %w", ctx.Err())
+		}
+	}
+}
+
+// flightKey identifies the flight of an exchange: its cache key, and its extra headers, which change the request sent.
+func flightKey(exchange *Exchange) string {
+	var key bytes.Buffer
+	key.WriteString(cacheKey(exchange))
+	key.WriteString("\n")
+	_ = exchange.Header.Write(&key)
+	return key.String()
+}
+
+// copyError returns a caller's copy of the error of a flight, with its own copy of the APIError it wraps, if any, so
+// that callers changing it, e.g. its Body, do not affect each other. Other errors carry no response, and are shared.
+func copyError(err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+	copied := *apiErr
+	copied.Body = bytes.Clone(apiErr.Body)
+	if err == error(apiErr) {
+		return &copied
+	}
+	return &flightError{err: err, apiErr: &copied}
+}
+
+// flightError is a caller's copy of an error wrapping an APIError: it reads and matches as the shared error, except
+// errors.As finds the caller's copy of the APIError.
+type flightError struct {
+	err    error
+	apiErr *APIError
+}
+
+func (e *flightError) Error() string {
+	return e.err.Error()
+}
+
+func (e *flightError) Unwrap() []error {
+	return []error{e.apiErr, e.err}
+}
+
+// start sends a request for the flight of key, with a context keeping the values of ctx, but not its cancellation.
+// The caller must hold mu.
+func (group *flightGroup) start(ctx context.Context, key string, next Handler, exchange Exchange) *flight {
+	flightCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	started := &flight{done: make(chan struct{}), cancel: cancel}
+	group.flights[key] = started
+	go func() {
+		defer cancel()
+		err := next(flightCtx, &exchange)
+		group.mu.Lock()
+		started.exchange, started.err = exchange, err
+		group.forget(key, started)
+		group.mu.Unlock()
+		close(started.done)
+	}()
+	return started
+}
+
+// forget removes the flight of key, if it is still f, so later requests start a new flight. The caller must hold mu.
+func (group *flightGroup) forget(key string, f *flight) {
+	if group.flights[key] == f {
+		delete(group.flights, key)
+	}
+}
@@ -0,0 +1,289 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// setupCoalesceTestAPI creates a PodcastIndexAPI coalescing requests, for a test server which holds each request
+// until release is closed.
+func setupCoalesceTestAPI(t *testing.T) (api *PodcastIndexAPI, requests *atomic.Int32, release chan struct{}) {
+	t.Helper()
+	requests = &atomic.Int32{}
+	release = make(chan struct{})
+	api, _ = setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		_, _ = w.Write([]byte(`{"items": ["` + r.URL.Query().Get("id") + `"]}`))
+	})
+	api.CoalesceRequests = true
+	return api, requests, release
+}
+
+// waitForCallers waits until n callers are waiting for the flight of the request to path, relative to the BaseURL.
+func waitForCallers(t *testing.T, api *PodcastIndexAPI, path string, n int) {
+	t.Helper()
+	key := api.BaseURL.String() + path + "\n"
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		api.flights.mu.Lock()
+		f := api.flights.flights[key]
+		callers := 0
+		if f != nil {
+			callers = f.callers
+		}
+		api.flights.mu.Unlock()
+		if callers == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("Timed out waiting for %d callers", n)
+}
+
+type itemsResponse struct {
+	Items []string `json:"items"`
+}
+
+func TestCoalesceSharesRequest(t *testing.T) {
+	api, requests, release := setupCoalesceTestAPI(t)
+
+	const callers = 10
+	results := make([]itemsResponse, callers)
+	errs := make([]error, callers)
+	var wg sync.WaitGroup
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = api.Get(context.Background(), "/podcasts/byfeedid", url.Values{"id": {"75075"}}, &results[i])
+		}()
+	}
+	waitForCallers(t, api, "/podcasts/byfeedid?id=75075", callers)
+	close(release)
+	wg.Wait()
+
+	if requests.Load() != 1 {
+		t.Errorf("Expected 1 request, got %d", requests.Load())
+	}
+	for i := range callers {
+		if errs[i] != nil || len(results[i].Items) != 1 || results[i].Items[0] != "75075" {
+			t.Fatalf("Expected each caller to get the result, got %+v, %v", results[i], errs[i])
+		}
+	}
+	t.Run("EachCallerGetsItsOwnCopy", func(t *testing.T) {
+		results[0].Items[0] = "changed"
+		if results[1].Items[0] != "75075" {
+			t.Errorf("Expected a change to one result not to affect another")
+		}
+	})
+	t.Run("LaterRequestsAreSentAgain", func(t *testing.T) {
+		var result itemsResponse
+		if err := api.Get(context.Background(), "/podcasts/byfeedid", url.Values{"id": {"75075"}}, &result); err != nil {
+			t.Fatal(err)
+		}
+		if requests.Load() != 2 {
+			t.Errorf("Expected a second request, got %d", requests.Load())
+		}
+	})
+}
+
+func TestCoalesceDifferentParams(t *testing.T) {
+	api, requests, release := setupCoalesceTestAPI(t)
+	close(release)
+
+	var wg sync.WaitGroup
+	for _, id := range []string{"1", "2"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var result itemsResponse
+			if err := api.Get(context.Background(), "/podcasts/byfeedid", url.Values{"id": {id}}, &result); err != nil || result.Items[0] != id {
+				t.Errorf("Expected the result for %s, got %+v, %v", id, result, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if requests.Load() != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests.Load())
+	}
+}
+
+func TestCoalesceCancellation(t *testing.T) {
+	api, requests, release := setupCoalesceTestAPI(t)
+	path := "/podcasts/byfeedid?id=75075"
+	params := url.Values{"id": {"75075"}}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	var cancelledErr, otherErr error
+	var other itemsResponse
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		var result itemsResponse
+		cancelledErr = api.Get(cancelled, "/podcasts/byfeedid", params, &result)
+	}()
+	waitForCallers(t, api, path, 1)
+	go func() {
+		defer wg.Done()
+		otherErr = api.Get(context.Background(), "/podcasts/byfeedid", params, &other)
+	}()
+	waitForCallers(t, api, path, 2)
+
+	cancel()
+	waitForCallers(t, api, path, 1)
+	close(release)
+	wg.Wait()
+
+	if !errors.Is(cancelledErr, context.Canceled) {
+		t.Errorf("Expected the cancelled caller to stop waiting, got %v", cancelledErr)
+	}
+	if otherErr != nil || len(other.Items) != 1 {
+		t.Errorf("Expected the other caller to get the result, got %+v, %v", other, otherErr)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("Expected 1 request, got %d", requests.Load())
+	}
+}
+
+func TestCoalesceCancelledByLastCaller(t *testing.T) {
+	api, requests, _ := setupCoalesceTestAPI(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- api.Get(ctx, "/podcasts/byfeedid", url.Values{"id": {"1"}}, nil)
+	}()
+	waitForCallers(t, api, "/podcasts/byfeedid?id=1", 1)
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the caller to stop waiting, got %v", err)
+	}
+
+	api.flights.mu.Lock()
+	inFlight := len(api.flights.flights)
+	api.flights.mu.Unlock()
+	if inFlight != 0 || requests.Load() != 1 {
+		t.Errorf("Expected the abandoned request to be forgotten, got %d in flight", inFlight)
+	}
+}
+
+// captureExchanges returns a Middleware recording the exchange of each request, setting its extra headers to those
+// given with the request's context, if any.
+func captureExchanges(mu *sync.Mutex, exchanges *[]*Exchange) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, exchange *Exchange) error {
+			if header, ok := ctx.Value(testHeaderKey{}).(http.Header); ok {
+				exchange.Header = header
+			}
+			err := next(ctx, exchange)
+			mu.Lock()
+			*exchanges = append(*exchanges, exchange)
+			mu.Unlock()
+			return err
+		}
+	}
+}
+
+type testHeaderKey struct{}
+
+func TestCoalesceCopiesResponseAndError(t *testing.T) {
+	release := make(chan struct{})
+	var requests atomic.Int32
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		w.Header().Set("X-Test", "shared")
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"description": "Server error"}`))
+	})
+	api.CoalesceRequests = true
+	var mu sync.Mutex
+	var exchanges []*Exchange
+	api.Middlewares = []Middleware{captureExchanges(&mu, &exchanges)}
+
+	errs := make([]error, 2)
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = api.Get(context.Background(), "/podcasts/byfeedid", url.Values{"id": {"75075"}}, nil)
+		}()
+	}
+	waitForCallers(t, api, "/podcasts/byfeedid?id=75075", 2)
+	close(release)
+	wg.Wait()
+
+	if requests.Load() != 1 || len(exchanges) != 2 {
+		t.Fatalf("Expected 1 request shared by 2 callers, got %d requests and %d exchanges", requests.Load(), len(exchanges))
+	}
+	t.Run("EachCallerGetsItsOwnResponse", func(t *testing.T) {
+		if exchanges[0].Response == exchanges[1].Response || exchanges[0].Request == exchanges[1].Request {
+			t.Fatal("Expected each caller to get its own copy of the request and response")
+		}
+		exchanges[0].Response.Header.Set("X-Test", "changed")
+		if exchanges[1].Response.Header.Get("X-Test") != "shared" {
+			t.Errorf("Expected a change to one response's headers not to affect another")
+		}
+	})
+	t.Run("EachCallerGetsItsOwnError", func(t *testing.T) {
+		var first, second *APIError
+		if !errors.As(errs[0], &first) || !errors.As(errs[1], &second) || !errors.Is(errs[1], ErrServer) {
+			t.Fatalf("Expected each caller to get an APIError, got %v and %v", errs[0], errs[1])
+		}
+		if first == second {
+			t.Fatal("Expected each caller to get its own APIError")
+		}
+		first.Body[0] = 'X'
+		if second.Body[0] != '{' {
+			t.Errorf("Expected a change to one error's body not to affect another")
+		}
+	})
+}
+
+func TestCoalesceDifferentHeaders(t *testing.T) {
+	api, requests, release := setupCoalesceTestAPI(t)
+	var mu sync.Mutex
+	var exchanges []*Exchange
+	api.Middlewares = []Middleware{captureExchanges(&mu, &exchanges)}
+
+	var wg sync.WaitGroup
+	for _, language := range []string{"en", "fr"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var result itemsResponse
+			ctx := context.WithValue(context.Background(), testHeaderKey{}, http.Header{"Accept-Language": {language}})
+			if err := api.Get(ctx, "/podcasts/byfeedid", url.Values{"id": {"75075"}}, &result); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for requests.Load() < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	if requests.Load() != 2 {
+		t.Errorf("Expected requests with different headers not to be coalesced, got %d requests", requests.Load())
+	}
+	for _, exchange := range exchanges {
+		if exchange.Request.Header.Get("Accept-Language") != exchange.Header.Get("Accept-Language") {
+			t.Errorf("Expected each request to be sent with its own headers, got %v", exchange.Request.Header)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+)
+
+// Credentials are the API key and secret requests to the PodcastIndex API are signed with.
+type Credentials struct {
+	// APIKey is the API key, sent as the X-Auth-Key header.
+	APIKey string
+	// APISecret is the API secret, used to sign requests; it is never sent.
+	APISecret string
+}
+
+// CredentialsProvider provides the Credentials to sign a request with. It is consulted each time a request is
+// signed, including retries, so the credentials it provides may change, e.g. when they are rotated.
+// Implementations must be safe for concurrent use.
+type CredentialsProvider interface {
+	// Credentials returns the credentials to sign a request with, or an error if none are available.
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// credentials returns the credentials to sign a request with: those of the Credentials provider if set,
+// otherwise APIKey and APISecret.
+func (api *PodcastIndexAPI) credentials(ctx context.Context) (Credentials, error) {
+	if api.Credentials == nil {
+		return Credentials{APIKey: api.APIKey, APISecret: api.APISecret}, nil
+	}
+	credentials, err := api.Credentials.Credentials(ctx)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to get credentials: %w", err)
+	}
+	return credentials, nil
+}
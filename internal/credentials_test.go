@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+// credentialsFunc is a CredentialsProvider calling a function.
+type credentialsFunc func(ctx context.Context) (Credentials, error)
+
+func (f credentialsFunc) Credentials(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+func TestCredentialsProvider(t *testing.T) {
+	var keys []string
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("X-Auth-Key"))
+		_, _ = w.Write([]byte(`{}`))
+	})
+	calls := 0
+	api.Credentials = credentialsFunc(func(ctx context.Context) (Credentials, error) {
+		calls++
+		if calls == 3 {
+			return Credentials{}, errors.New("secret store unavailable")
+		}
+		return Credentials{APIKey: []string{"", "first", "second"}[calls], APISecret: "secret"}, nil
+	})
+
+	var result struct{}
+	for range 2 {
+		if err := api.Get(context.Background(), "/test", nil, &result); err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+	}
+	if len(keys) != 2 || keys[0] != "first" || keys[1] != "second" {
+		t.Errorf("Expected the provider to be consulted for each request, got keys %v", keys)
+	}
+	err := api.Get(context.Background(), "/test", nil, &result)
+	if err == nil || len(keys) != 2 {
+		t.Errorf("Expected the request to fail without being sent, got %v", err)
+	}
+}
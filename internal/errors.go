@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+var (
+	// ErrUnauthorized is wrapped by the error returned when the PodcastIndex API rejects a request's credentials,
+	// i.e. responds with 401 Unauthorized or 403 Forbidden.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrNotFound is wrapped by the error returned when the PodcastIndex API responds with 404 Not Found,
+	// or reports "status": "false" for a lookup it could not find a result for.
+	ErrNotFound = errors.New("not found")
+	// ErrRateLimited is wrapped by the error returned when the PodcastIndex API responds with 429 Too Many Requests.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrBadRequest is wrapped by the error returned when the PodcastIndex API responds with 400 Bad Request.
+	ErrBadRequest = errors.New("bad request")
+	// ErrServer is wrapped by the error returned when the PodcastIndex API responds with a 5xx status code.
+	ErrServer = errors.New("server error")
+	// ErrDecode is wrapped by the error returned when a response from the PodcastIndex API cannot be decoded.
+	ErrDecode = errors.New("failed to decode response")
+)
+
+// APIError is the error returned when the PodcastIndex API responds to a request with an error.
+//
+// It wraps one of ErrUnauthorized, ErrNotFound, ErrRateLimited, ErrBadRequest or ErrServer where the
+// response matches, so callers can use errors.Is to check the kind of error, and errors.As to inspect the response.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Endpoint is the endpoint the request was made to, e.g. /podcasts/byfeedid
+	Endpoint string
+	// URL is the full URL the request was made to. It contains no secrets, as credentials are sent as headers.
+	URL string
+	// Description is the description reported by the API in the response body, if any.
+	Description string
+	// Body is the raw response body.
+	Body []byte
+	// RetryAfter is the delay the API asked for with a Retry-After header, if any, typically with a 429 or 503.
+	RetryAfter time.Duration
+	// kind is the sentinel error matching the response, if any.
+	kind error
+}
+
+// newAPIError creates the APIError for a response, picking the sentinel error matching its status code.
+func newAPIError(endpoint string, resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Endpoint:   endpoint,
+		URL:        resp.Request.URL.String(),
+		Body:       body,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+	var envelope struct {
+		Description string `json:"description"`
+	}
+	if json.Unmarshal(body, &envelope) == nil {
+		apiErr.Description = envelope.Description
+	}
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		apiErr.kind = ErrUnauthorized
+	case resp.StatusCode == http.StatusNotFound:
+		apiErr.kind = ErrNotFound
+	case resp.StatusCode == http.StatusTooManyRequests:
+		apiErr.kind = ErrRateLimited
+	case resp.StatusCode == http.StatusBadRequest:
+		apiErr.kind = ErrBadRequest
+	case resp.StatusCode >= http.StatusInternalServerError:
+		apiErr.kind = ErrServer
+	}
+	return apiErr
+}
+
+// Error describes the error, and what can be done about it where known.
+func (e *APIError) Error() string {
+	switch {
+	case e.StatusCode == http.StatusUnauthorized:
+		return fmt.Sprintf("authentication error when making request to podcast index API (%s), please verify your API key and API secret values are correct", e.URL)
+	case e.StatusCode == http.StatusForbidden:
+		return fmt.Sprintf("podcast index API at %s returned status code %d, the API key is not permitted to make this request", e.URL, e.StatusCode)
+	case e.StatusCode == http.StatusBadRequest:
+		return fmt.Sprintf("podcast index API at %s returned status code %d. This usually indicates a malformed request, potentially a bug in this client or an API change. Please file an issue at https://github.com/jjgmckenzie/podcast-index/issues with steps to reproduce the error", e.URL, e.StatusCode)
+	case e.StatusCode == http.StatusOK && e.kind == ErrNotFound:
+		return fmt.Sprintf("podcast index API at %s found no result: %s", e.URL, e.Description)
+	case e.StatusCode == http.StatusOK:
+		return fmt.Sprintf("podcast index API at %s reported an error: %s", e.URL, e.Description)
+	case len(e.Body) == 0:
+		return fmt.Sprintf("podcast index API at %s returned status code %d", e.URL, e.StatusCode)
+	}
+	return fmt.Sprintf("podcast index API at %s returned status code %d with response: %s", e.URL, e.StatusCode, e.Body)
+}
+
+// Unwrap returns the sentinel error matching the response, so errors.Is can be used to check the kind of error.
+func (e *APIError) Unwrap() error {
+	return e.kind
+}
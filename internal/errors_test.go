@@ -0,0 +1,106 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAPIErrorSentinels(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statusCode int
+		body       string
+		expected   error
+	}{
+		{name: "Unauthorized", statusCode: http.StatusUnauthorized, expected: ErrUnauthorized},
+		{name: "Forbidden", statusCode: http.StatusForbidden, expected: ErrUnauthorized},
+		{name: "NotFound", statusCode: http.StatusNotFound, expected: ErrNotFound},
+		{name: "TooManyRequests", statusCode: http.StatusTooManyRequests, expected: ErrRateLimited},
+		{name: "BadRequest", statusCode: http.StatusBadRequest, expected: ErrBadRequest},
+		{name: "ServerError", statusCode: http.StatusBadGateway, expected: ErrServer},
+		{name: "StatusFalse", statusCode: http.StatusOK, body: `{"status": "false", "description": "No feeds match this id."}`, expected: ErrNotFound},
+		{name: "StatusFalseBoolean", statusCode: http.StatusOK, body: `{"status": false, "description": "No feeds match this id."}`, expected: ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte(tc.body))
+			})
+
+			var result struct{}
+			err := api.Get(context.Background(), "podcasts/byfeedid", nil, &result)
+			if !errors.Is(err, tc.expected) {
+				t.Fatalf("Expected error to wrap %v, got %v", tc.expected, err)
+			}
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("Expected an *APIError, got %T", err)
+			}
+			if apiErr.StatusCode != tc.statusCode {
+				t.Errorf("Expected status code %d, got %d", tc.statusCode, apiErr.StatusCode)
+			}
+			if apiErr.Endpoint != "podcasts/byfeedid" {
+				t.Errorf("Expected endpoint %q, got %q", "podcasts/byfeedid", apiErr.Endpoint)
+			}
+		})
+	}
+}
+
+func TestStatusFalseOutsideLookups(t *testing.T) {
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status": "false", "description": "Feed url is not valid."}`))
+	})
+
+	var result struct{}
+	err := api.Get(context.Background(), "/add/byfeedurl", nil, &result)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected an *APIError, got %T", err)
+	}
+	if errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected a failure outside of a lookup not to wrap ErrNotFound, got %v", err)
+	}
+	if apiErr.Description != "Feed url is not valid." {
+		t.Errorf("Expected description %q, got %q", "Feed url is not valid.", apiErr.Description)
+	}
+}
+
+func TestAPIErrorDescription(t *testing.T) {
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"status": "false", "description": "Something went wrong"}`))
+	})
+
+	var result struct{}
+	err := api.Get(context.Background(), "test/description", nil, &result)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected an *APIError, got %T", err)
+	}
+	if apiErr.Description != "Something went wrong" {
+		t.Errorf("Expected description %q, got %q", "Something went wrong", apiErr.Description)
+	}
+	if string(apiErr.Body) != `{"status": "false", "description": "Something went wrong"}` {
+		t.Errorf("Expected the raw body, got %q", apiErr.Body)
+	}
+}
+
+func TestDecodeErrorIsDistinct(t *testing.T) {
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`not json`))
+	})
+
+	var result struct{}
+	err := api.Get(context.Background(), "test/decode", nil, &result)
+	if !errors.Is(err, ErrDecode) {
+		t.Fatalf("Expected error to wrap ErrDecode, got %v", err)
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		t.Errorf("Expected a decode error not to be an *APIError")
+	}
+}
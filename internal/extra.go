@@ -0,0 +1,94 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// JSONFieldNames returns the JSON names of the fields of a struct type, from their json tags, or their names.
+//
+// Names are lower case, as encoding/json matches the keys of an object to fields case-insensitively.
+func JSONFieldNames(structType reflect.Type) map[string]bool {
+	names := make(map[string]bool, structType.NumField())
+	for i := range structType.NumField() {
+		field := structType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[strings.ToLower(name)] = true
+	}
+	return names
+}
+
+// isKnownField reports whether name matches one of the known names returned by JSONFieldNames, ignoring case as
+// encoding/json does.
+func isKnownField(known map[string]bool, name string) bool {
+	return known[strings.ToLower(name)]
+}
+
+// UnknownFields returns the fields of a JSON object which are not known, or nil if it has none.
+//
+// Returns: an error if data is not a JSON object
+func UnknownFields(data []byte, known map[string]bool) (map[string]json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	var unknown map[string]json.RawMessage
+	for name, value := range fields {
+		if isKnownField(known, name) {
+			continue
+		}
+		if unknown == nil {
+			unknown = map[string]json.RawMessage{}
+		}
+		unknown[name] = value
+	}
+	return unknown, nil
+}
+
+// AppendFields adds fields to the end of a JSON object, in order of their names, skipping any which are known,
+// so they cannot duplicate the fields of the object.
+//
+// Returns: an error if object is not a JSON object, or a field's value is not valid JSON
+func AppendFields(object []byte, fields map[string]json.RawMessage, known map[string]bool) ([]byte, error) {
+	if len(fields) == 0 {
+		return object, nil
+	}
+	object = bytes.TrimSpace(object)
+	if len(object) < 2 || object[0] != '{' || object[len(object)-1] != '}' {
+		return nil, fmt.Errorf("cannot append fields to %q: not a JSON object", object)
+	}
+	result := bytes.Clone(object[:len(object)-1])
+	empty := len(bytes.TrimSpace(result)) == 1
+	for _, name := range slices.Sorted(maps.Keys(fields)) {
+		if isKnownField(known, name) {
+			continue
+		}
+		value := fields[name]
+		if !json.Valid(value) {
+			return nil, fmt.Errorf("cannot append field %q: its value is not valid JSON", name)
+		}
+		encodedName, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		if !empty {
+			result = append(result, ',')
+		}
+		empty = false
+		result = append(result, encodedName...)
+		result = append(result, ':')
+		result = append(result, value...)
+	}
+	return append(result, '}'), nil
+}
@@ -0,0 +1,76 @@
+package internal
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestJSONFieldNames(t *testing.T) {
+	type example struct {
+		ID       int    `json:"id"`
+		Title    string `json:"title,omitempty"`
+		Ignored  string `json:"-"`
+		Untagged string
+	}
+	names := JSONFieldNames(reflect.TypeFor[example]())
+	expected := map[string]bool{"id": true, "title": true, "untagged": true}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	known := map[string]bool{"id": true}
+	unknown, err := UnknownFields([]byte(`{"id": 1, "new": {"a": true}}`), known)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unknown) != 1 || string(unknown["new"]) != `{"a": true}` {
+		t.Errorf("expected the unknown field, got %v", unknown)
+	}
+	unknown, err = UnknownFields([]byte(`{"id": 1}`), known)
+	if err != nil || unknown != nil {
+		t.Errorf("expected no unknown fields, got %v, %v", unknown, err)
+	}
+	unknown, err = UnknownFields([]byte(`{"ID": 1}`), known)
+	if err != nil || unknown != nil {
+		t.Errorf("expected a known field in another case not to be unknown, got %v, %v", unknown, err)
+	}
+	if _, err := UnknownFields([]byte(`[]`), known); err == nil {
+		t.Error("expected an error for a JSON array")
+	}
+}
+
+func TestAppendFields(t *testing.T) {
+	known := map[string]bool{"id": true}
+	fields := map[string]json.RawMessage{"b": json.RawMessage(`2`), "a": json.RawMessage(`"x"`), "id": json.RawMessage(`3`), "Id": json.RawMessage(`4`)}
+
+	tests := []struct {
+		name     string
+		object   string
+		expected string
+	}{
+		{"fields are appended in order of their names, skipping known fields in any case", `{"id":1}`, `{"id":1,"a":"x","b":2}`},
+		{"fields are appended to an empty object", `{}`, `{"a":"x","b":2}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := AppendFields([]byte(test.object), fields, known)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(result) != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, result)
+			}
+		})
+	}
+	t.Run("invalid values are rejected", func(t *testing.T) {
+		if _, err := AppendFields([]byte(`{}`), map[string]json.RawMessage{"a": json.RawMessage(`{`)}, known); err == nil {
+			t.Error("expected an error for an invalid value")
+		}
+		if _, err := AppendFields([]byte(`[]`), fields, known); err == nil {
+			t.Error("expected an error for a JSON array")
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ResponseMetadata is the metadata of a response from the PodcastIndex API: the fields of its envelope, which are
+// returned alongside the result, and the details of the request it answered.
+type ResponseMetadata struct {
+	// Status is the status reported by the API: "true" for a successful request, or "false".
+	Status string
+	// Count is the number of items the API reported returning.
+	Count int
+	// Query is the query echoed back by the API, as JSON: a string for searches, or an object for lookups;
+	// nil if the response did not echo the query.
+	Query json.RawMessage
+	// Description is the API's description of the response, e.g. "Found matching feeds".
+	Description string
+	// URL is the URL of the request, including its query parameters; the credentials are sent in headers, not the URL,
+	// and any user info is redacted.
+	URL string
+	// StatusCode is the HTTP status code of the response; zero if it was served from cache, or none was received.
+	StatusCode int
+	// Header are the headers of the response; nil if it was served from cache, or none was received.
+	Header http.Header
+	// Elapsed is how long the request took, from the call to the response: including any retries and their backoff,
+	// waiting for the rate limiter, or waiting for a coalesced request.
+	Elapsed time.Duration
+	// Cached is whether the response was served from the cache, rather than by the API.
+	Cached bool
+}
+
+// QueryString returns the query echoed back by the API as a string: the string itself, if the API echoed a string,
+// or else the JSON it echoed.
+func (metadata ResponseMetadata) QueryString() string {
+	var query string
+	if json.Unmarshal(metadata.Query, &query) == nil {
+		return query
+	}
+	return string(metadata.Query)
+}
+
+// MetadataCollector collects the metadata of the responses to the requests made with a context from
+// WithMetadataCollector; it is safe for concurrent use.
+type MetadataCollector struct {
+	mu        sync.Mutex
+	metadata  ResponseMetadata
+	responses int
+}
+
+// Collected returns the metadata of the response collected, and how many responses were collected.
+//
+// If several were collected, the metadata is that of the last to complete, which for concurrent requests may differ
+// from one run to the next.
+func (collector *MetadataCollector) Collected() (ResponseMetadata, int) {
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	return collector.metadata, collector.responses
+}
+
+type metadataCollectorKey struct{}
+
+// WithMetadataCollector returns a context recording the metadata of the responses to the requests made with it
+// into collector.
+func WithMetadataCollector(ctx context.Context, collector *MetadataCollector) context.Context {
+	return context.WithValue(ctx, metadataCollectorKey{}, collector)
+}
+
+// collectMetadata records the metadata of an exchange, which took elapsed from start to finish, into the
+// MetadataCollector of ctx, if it has one.
+func collectMetadata(ctx context.Context, exchange *Exchange, elapsed time.Duration) {
+	if ctx == nil {
+		return
+	}
+	collector, ok := ctx.Value(metadataCollectorKey{}).(*MetadataCollector)
+	if !ok {
+		return
+	}
+	metadata := ResponseMetadata{
+		URL:     exchange.redactedURL(),
+		Elapsed: elapsed,
+		Cached:  exchange.Request == nil && exchange.ResponseBody != nil,
+	}
+	if exchange.Response != nil {
+		metadata.StatusCode = exchange.Response.StatusCode
+		metadata.Header = exchange.Response.Header.Clone()
+	}
+	var envelope struct {
+		Status      any             `json:"status"`
+		Count       json.RawMessage `json:"count"`
+		Query       json.RawMessage `json:"query"`
+		Description string          `json:"description"`
+	}
+	if json.Unmarshal(exchange.ResponseBody, &envelope) == nil {
+		if envelope.Status != nil {
+			metadata.Status = fmt.Sprint(envelope.Status)
+		}
+		metadata.Count = parseCount(envelope.Count)
+		metadata.Query = envelope.Query
+		metadata.Description = envelope.Description
+	}
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	collector.metadata = metadata
+	collector.responses++
+}
+
+// parseCount parses the count of a response, which the API reports as a number, or occasionally a string.
+func parseCount(count json.RawMessage) int {
+	var number int
+	if json.Unmarshal(count, &number) == nil {
+		return number
+	}
+	var text string
+	if json.Unmarshal(count, &text) == nil {
+		number, _ = strconv.Atoi(text)
+	}
+	return number
+}
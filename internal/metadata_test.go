@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCollectMetadata(t *testing.T) {
+	api, server := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "abc")
+		_, _ = w.Write([]byte(`{"status": true, "count": "2", "query": {"id": "75075"}, "description": "Found matching items"}`))
+	})
+	var collector MetadataCollector
+	ctx := WithMetadataCollector(context.Background(), &collector)
+	var result struct{}
+	if err := api.Get(ctx, "/episodes/byfeedid", map[string][]string{"id": {"75075"}}, &result); err != nil {
+		t.Fatal(err)
+	}
+
+	metadata, responses := collector.Collected()
+	if responses != 1 {
+		t.Fatalf("expected the metadata of 1 response to be collected, got %d", responses)
+	}
+	if metadata.Status != "true" || metadata.Count != 2 || metadata.Description != "Found matching items" {
+		t.Errorf("unexpected envelope fields %+v", metadata)
+	}
+	if metadata.QueryString() != `{"id": "75075"}` {
+		t.Errorf("expected the query object, got %s", metadata.QueryString())
+	}
+	if metadata.URL != server.URL+"/episodes/byfeedid?id=75075" {
+		t.Errorf("unexpected URL %s", metadata.URL)
+	}
+	if metadata.StatusCode != http.StatusOK || metadata.Header.Get("X-Request-Id") != "abc" || metadata.Cached {
+		t.Errorf("unexpected response details %+v", metadata)
+	}
+}
+
+func TestCollectMetadataError(t *testing.T) {
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status": "false", "description": "Missing q"}`))
+	})
+	var collector MetadataCollector
+	var result struct{}
+	err := api.Get(WithMetadataCollector(context.Background(), &collector), "/search/byterm", nil, &result)
+	if !errors.Is(err, ErrBadRequest) {
+		t.Fatalf("expected ErrBadRequest, got %v", err)
+	}
+	metadata, _ := collector.Collected()
+	if metadata.StatusCode != http.StatusBadRequest || metadata.Description != "Missing q" {
+		t.Errorf("expected the metadata of the error response, got %+v", metadata)
+	}
+}
+
+func TestCollectMetadataCached(t *testing.T) {
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status": "true", "count": 1}`))
+	})
+	api.Cache = newMapCache()
+	api.CacheTTL = func(string) time.Duration { return time.Minute }
+	var result struct{}
+	if err := api.Get(context.Background(), "/categories/list", nil, &result); err != nil {
+		t.Fatal(err)
+	}
+	var collector MetadataCollector
+	if err := api.Get(WithMetadataCollector(context.Background(), &collector), "/categories/list", nil, &result); err != nil {
+		t.Fatal(err)
+	}
+	metadata, _ := collector.Collected()
+	if !metadata.Cached || metadata.Header != nil || metadata.Count != 1 {
+		t.Errorf("expected the metadata of the cached response, got %+v", metadata)
+	}
+}
+
+func TestCollectMetadataElapsedIncludesRetries(t *testing.T) {
+	attempts := 0
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status": "true"}`))
+	})
+	api.RetryPolicy = &RetryPolicy{MaxAttempts: 2, BaseDelay: 50 * time.Millisecond}
+	var collector MetadataCollector
+	var result struct{}
+	if err := api.Get(WithMetadataCollector(context.Background(), &collector), "/categories/list", nil, &result); err != nil {
+		t.Fatal(err)
+	}
+	metadata, _ := collector.Collected()
+	if metadata.Elapsed < 50*time.Millisecond {
+		t.Errorf("expected the elapsed time to include the retry's backoff, got %s", metadata.Elapsed)
+	}
+}
+
+func TestMetadataCollectorEmpty(t *testing.T) {
+	var collector MetadataCollector
+	if _, responses := collector.Collected(); responses != 0 {
+		t.Error("expected no metadata before a request is made")
+	}
+	metadata := ResponseMetadata{Query: []byte(`"batman"`)}
+	if metadata.QueryString() != "batman" {
+		t.Errorf("expected the query string, got %q", metadata.QueryString())
+	}
+}
@@ -0,0 +1,86 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Exchange is a single request to the PodcastIndex API, and its response, as it passes through the middleware chain.
+//
+// Middlewares may change the request fields before calling the next Handler, e.g. to add a query parameter or header,
+// and read the response fields after it returns. When a request is retried, the response fields describe the last attempt.
+type Exchange struct {
+	// Method is the HTTP method of the request, e.g. GET.
+	Method string
+	// Endpoint is the endpoint the request is made to, e.g. /podcasts/byfeedid
+	Endpoint string
+	// URL is the URL the request is made to, without its query parameters.
+	URL *url.URL
+	// Params are the query parameters of the request.
+	Params url.Values
+	// Body is the JSON encoded body of the request, or nil if it has none.
+	Body []byte
+	// Header are extra headers to send with the request; the authentication headers are always set by the client.
+	Header http.Header
+	// Logger is the client's logger, with the method and endpoint of the request as attributes.
+	Logger *slog.Logger
+
+	// Request is the signed request, once sent; it is nil if no request was sent, e.g. as the response was cached.
+	Request *http.Request
+	// Response is the response to Request, if one was received; its body has already been read into ResponseBody.
+	Response *http.Response
+	// ResponseBody is the body of the response, to be decoded into the result of the call.
+	ResponseBody []byte
+	// Sent is when Request was sent.
+	Sent time.Time
+	// Elapsed is how long it took to receive Response, or the error sending Request.
+	Elapsed time.Duration
+}
+
+// requestURL returns the URL of the request, including its query parameters.
+func (exchange *Exchange) requestURL() string {
+	requestURL := *exchange.URL
+	requestURL.RawQuery = exchange.Params.Encode()
+	return requestURL.String()
+}
+
+// redactedURL returns the URL of the request, including its query parameters, with any user info redacted.
+func (exchange *Exchange) redactedURL() string {
+	requestURL := *exchange.URL
+	requestURL.RawQuery = exchange.Params.Encode()
+	return requestURL.Redacted()
+}
+
+// logger returns the Logger of the exchange, or a logger discarding everything if it has none.
+// RequestLogger returns the logger of a request's exchange: logger, or one discarding diagnostics if nil, with the
+// method and endpoint of the request as attributes.
+func RequestLogger(logger *slog.Logger, method string, endpoint string) *slog.Logger {
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+	return logger.With("method", method, "endpoint", endpoint)
+}
+
+func (exchange *Exchange) logger() *slog.Logger {
+	if exchange.Logger == nil {
+		return slog.New(slog.DiscardHandler)
+	}
+	return exchange.Logger
+}
+
+// Handler handles an Exchange, setting its ResponseBody, or returning an error.
+type Handler func(ctx context.Context, exchange *Exchange) error
+
+// Middleware wraps a Handler, e.g. to log, measure, retry or short-circuit requests.
+type Middleware func(next Handler) Handler
+
+// Chain wraps handler in middlewares, so that the first middleware is the outermost, and sees each request first.
+func Chain(handler Handler, middlewares ...Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
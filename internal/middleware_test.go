@@ -0,0 +1,141 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestChainOrder(t *testing.T) {
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, exchange *Exchange) error {
+				calls = append(calls, name+" before")
+				err := next(ctx, exchange)
+				calls = append(calls, name+" after")
+				return err
+			}
+		}
+	}
+	handler := Chain(func(ctx context.Context, exchange *Exchange) error {
+		calls = append(calls, "handler")
+		return nil
+	}, record("first"), record("second"))
+
+	if err := handler(context.Background(), &Exchange{}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	expected := "first before,second before,handler,second after,first after"
+	if strings.Join(calls, ",") != expected {
+		t.Errorf("Expected calls %q, got %q", expected, strings.Join(calls, ","))
+	}
+}
+
+func TestMiddlewareExchange(t *testing.T) {
+	var capturedRequest *http.Request
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		capturedRequest = r
+		_, _ = w.Write([]byte(`{"status": "true"}`))
+	})
+	var seen Exchange
+	api.Middlewares = []Middleware{func(next Handler) Handler {
+		return func(ctx context.Context, exchange *Exchange) error {
+			exchange.Header.Set("X-Request-Id", "abc")
+			exchange.Params.Set("pretty", "")
+			err := next(ctx, exchange)
+			seen = *exchange
+			return err
+		}
+	}}
+
+	var result struct{}
+	if err := api.Get(context.Background(), "/podcasts/byfeedid", url.Values{"id": {"75075"}}, &result); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	t.Run("SeesTheRequest", func(t *testing.T) {
+		if seen.Method != http.MethodGet || seen.Endpoint != "/podcasts/byfeedid" || seen.Params.Get("id") != "75075" {
+			t.Errorf("Expected the method, endpoint and params of the request, got %+v", seen)
+		}
+		if seen.Request == nil || seen.Request.Header.Get("Authorization") == "" {
+			t.Errorf("Expected the signed request")
+		}
+	})
+	t.Run("SeesTheResponse", func(t *testing.T) {
+		if seen.Response == nil || seen.Response.StatusCode != http.StatusOK || string(seen.ResponseBody) != `{"status": "true"}` {
+			t.Errorf("Expected the response and its body, got %+v", seen)
+		}
+		if seen.Sent.IsZero() || seen.Elapsed <= 0 {
+			t.Errorf("Expected the request to be timed, got sent %s, elapsed %s", seen.Sent, seen.Elapsed)
+		}
+	})
+	t.Run("ChangesTheRequest", func(t *testing.T) {
+		if capturedRequest.Header.Get("X-Request-Id") != "abc" {
+			t.Errorf("Expected the added header to be sent")
+		}
+		if capturedRequest.URL.RawQuery != "id=75075&pretty=" {
+			t.Errorf("Expected the added param to be sent, got %q", capturedRequest.URL.RawQuery)
+		}
+	})
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	requests := 0
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+	injected := errors.New("injected fault")
+	api.Middlewares = []Middleware{func(next Handler) Handler {
+		return func(ctx context.Context, exchange *Exchange) error {
+			if exchange.Endpoint == "/fault" {
+				return injected
+			}
+			exchange.ResponseBody = []byte(`{"count": 7}`)
+			return nil
+		}
+	}}
+
+	var result struct {
+		Count int `json:"count"`
+	}
+	if err := api.Get(context.Background(), "/fault", nil, &result); !errors.Is(err, injected) {
+		t.Errorf("Expected the injected fault, got %v", err)
+	}
+	if err := api.Get(context.Background(), "/fake", nil, &result); err != nil || result.Count != 7 {
+		t.Errorf("Expected the fake response to be decoded, got %+v, %v", result, err)
+	}
+	if requests != 0 {
+		t.Errorf("Expected no requests to reach the server, got %d", requests)
+	}
+}
+
+func TestMiddlewaresRunOutsideRetries(t *testing.T) {
+	attempts, calls := 0, 0
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+	api.RetryPolicy = &RetryPolicy{MaxAttempts: 2}
+	api.Middlewares = []Middleware{func(next Handler) Handler {
+		return func(ctx context.Context, exchange *Exchange) error {
+			calls++
+			return next(ctx, exchange)
+		}
+	}}
+
+	var result struct{}
+	if err := api.Get(context.Background(), "/retried", nil, &result); err != nil {
+		t.Fatalf("Expected the retry to succeed, got %v", err)
+	}
+	if attempts != 2 || calls != 1 {
+		t.Errorf("Expected 2 attempts within 1 middleware call, got %d attempts and %d calls", attempts, calls)
+	}
+}
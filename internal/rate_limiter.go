@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket limiting the rate of requests made to the PodcastIndex API.
+//
+// It is safe for concurrent use, and may be shared between several clients using the same API key,
+// so that together they stay within the rate the API allows.
+type RateLimiter struct {
+	mu sync.Mutex
+	// rate is the number of tokens added to the bucket per second.
+	rate float64
+	// burst is the capacity of the bucket.
+	burst float64
+	// tokens is the number of tokens in the bucket as of last; negative when requests have reserved future tokens.
+	tokens float64
+	last   time.Time
+	stats  RateLimiterStats
+	// now returns the current time; defaults to time.Now, and is overridden in tests.
+	now func() time.Time
+}
+
+// RateLimiterStats is a snapshot of the state of a RateLimiter.
+type RateLimiterStats struct {
+	// Waiting is the number of requests currently queued, waiting for the limiter.
+	Waiting int
+	// NextWait is how long a request made now would wait before being sent.
+	NextWait time.Duration
+	// Requests is the total number of requests let through the limiter.
+	Requests int64
+	// Waited is the total number of requests which had to wait before being let through.
+	Waited int64
+	// TotalWait is the total time requests have waited for the limiter.
+	TotalWait time.Duration
+	// MaxWait is the longest time a single request has waited for the limiter.
+	MaxWait time.Duration
+}
+
+// NewRateLimiter creates a RateLimiter allowing requestsPerSecond requests per second on average,
+// with bursts of up to burst requests. burst is raised to 1 if lower.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	burst = max(burst, 1)
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// refill adds the tokens accrued since the bucket was last updated; the caller must hold mu.
+func (limiter *RateLimiter) refill(now time.Time) {
+	if !limiter.last.IsZero() {
+		elapsed := now.Sub(limiter.last).Seconds()
+		limiter.tokens = min(limiter.tokens+elapsed*limiter.rate, limiter.burst)
+	}
+	limiter.last = now
+}
+
+// delayFor returns how long the request holding the given token balance must wait; the caller must hold mu.
+func (limiter *RateLimiter) delayFor(tokens float64) time.Duration {
+	if tokens >= 0 {
+		return 0
+	}
+	if limiter.rate <= 0 {
+		return time.Duration(1<<63 - 1)
+	}
+	return time.Duration(-tokens / limiter.rate * float64(time.Second))
+}
+
+// Wait blocks until the limiter lets a request through, or the context ends.
+//
+// Returns: an error wrapping context.DeadlineExceeded, without waiting, if the request would not be let through
+// before the context's deadline, or the context's error if it is cancelled while waiting
+func (limiter *RateLimiter) Wait(ctx context.Context) error {
+	limiter.mu.Lock()
+	now := limiter.now()
+	limiter.refill(now)
+	limiter.tokens--
+	delay := limiter.delayFor(limiter.tokens)
+	if deadline, ok := ctx.Deadline(); ok && delay > 0 && now.Add(delay).After(deadline) {
+		limiter.tokens++
+		limiter.mu.Unlock()
+		return fmt.Errorf("rate limiter would wait %s, past the context deadline: %w", delay, context.DeadlineExceeded)
+	}
+	if delay == 0 {
+		limiter.stats.Requests++
+		limiter.mu.Unlock()
+		return nil
+	}
+	limiter.stats.Waiting++
+	limiter.mu.Unlock()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+		limiter.stats.Waiting--
+		// give back the reserved token, so that requests queued behind this one are not delayed by it.
+		limiter.tokens++
+		return fmt.Errorf("stopped waiting for rate limiter: %w", ctx.Err())
+	case <-timer.C:
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+		limiter.stats.Waiting--
+		limiter.stats.Requests++
+		limiter.stats.Waited++
+		limiter.stats.TotalWait += delay
+		limiter.stats.MaxWait = max(limiter.stats.MaxWait, delay)
+		return nil
+	}
+}
+
+// Stats returns a snapshot of the limiter's current state, e.g. to alert before the API starts rejecting requests.
+func (limiter *RateLimiter) Stats() RateLimiterStats {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	limiter.refill(limiter.now())
+	stats := limiter.stats
+	stats.NextWait = limiter.delayFor(limiter.tokens - 1)
+	return stats
+}
+
+// RateLimitMiddleware returns a Middleware making each request wait for limiter before it is sent.
+func RateLimitMiddleware(limiter *RateLimiter) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, exchange *Exchange) error {
+			start := time.Now()
+			if err := limiter.Wait(ctx); err != nil {
+				exchange.logger().WarnContext(ctx, "podcast index API request not sent, as the rate limiter would not let it through in time", "error", err)
+				return err
+			}
+			if waited := time.Since(start); waited >= time.Millisecond {
+				exchange.logger().DebugContext(ctx, "waited for rate limiter", "waited", waited)
+			}
+			return next(ctx, exchange)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBurst(t *testing.T) {
+	limiter := NewRateLimiter(1, 3)
+	clock := time.Unix(1700000000, 0)
+	limiter.now = func() time.Time { return clock }
+
+	for i := range 3 {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatalf("Expected request %d to be let through without waiting, got %v", i, err)
+		}
+	}
+	stats := limiter.Stats()
+	if stats.Requests != 3 || stats.Waited != 0 {
+		t.Errorf("Expected 3 requests and none waiting, got %+v", stats)
+	}
+	if stats.NextWait != time.Second {
+		t.Errorf("Expected the next request to wait 1s, got %s", stats.NextWait)
+	}
+
+	clock = clock.Add(2 * time.Second)
+	if stats := limiter.Stats(); stats.NextWait != 0 {
+		t.Errorf("Expected tokens to have refilled, got a wait of %s", stats.NextWait)
+	}
+}
+
+func TestRateLimiterWaits(t *testing.T) {
+	limiter := NewRateLimiter(100, 1)
+	start := time.Now()
+	for range 3 {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+		t.Errorf("Expected 3 requests at 100/s with a burst of 1 to take at least 20ms, took %s", elapsed)
+	}
+	stats := limiter.Stats()
+	if stats.Requests != 3 || stats.Waited != 2 || stats.TotalWait <= 0 || stats.MaxWait <= 0 {
+		t.Errorf("Expected 3 requests, 2 of which waited, got %+v", stats)
+	}
+}
+
+func TestRateLimiterDeadline(t *testing.T) {
+	limiter := NewRateLimiter(1.0/3600, 1)
+	_ = limiter.Wait(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	start := time.Now()
+	err := limiter.Wait(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected an error wrapping context.DeadlineExceeded, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("Expected not to wait when the deadline is before the request would be let through")
+	}
+	if stats := limiter.Stats(); stats.Requests != 1 || stats.NextWait > time.Hour {
+		t.Errorf("Expected the rejected request not to reserve a token, got %+v", stats)
+	}
+}
+
+func TestRateLimiterCancel(t *testing.T) {
+	limiter := NewRateLimiter(1.0/3600, 1)
+	_ = limiter.Wait(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	var err error
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err = limiter.Wait(ctx)
+	}()
+	for limiter.Stats().Waiting == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	wg.Wait()
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected an error wrapping context.Canceled, got %v", err)
+	}
+	if stats := limiter.Stats(); stats.Waiting != 0 || stats.Requests != 1 {
+		t.Errorf("Expected the cancelled request to leave the queue, got %+v", stats)
+	}
+}
+
+func TestRateLimiterAppliesToRequests(t *testing.T) {
+	requests := 0
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{}`))
+	})
+	api.RateLimiter = NewRateLimiter(1.0/3600, 1)
+
+	var result struct{}
+	if err := api.Get(context.Background(), "test/limited", nil, &result); err != nil {
+		t.Fatalf("Expected the first request to succeed, got %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := api.Get(ctx, "test/limited", nil, &result); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the second request to be limited, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request to reach the server, got %d", requests)
+	}
+}
@@ -0,0 +1,157 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// DefaultRetryableStatuses are the status codes retried when a RetryPolicy does not set RetryableStatuses.
+var DefaultRetryableStatuses = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy configures how failed requests to the PodcastIndex API are retried.
+//
+// Each attempt is a new request, signed with a fresh X-Auth-Date. Delays grow exponentially from BaseDelay,
+// capped at MaxDelay, unless the API responds with a Retry-After header, which is honored instead.
+// Retrying stops early if the request's context is cancelled, or its deadline falls before the next attempt.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first; values below 2 disable retries.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, doubled for each retry after it.
+	BaseDelay time.Duration
+	// MaxDelay (Optional) caps the delay between attempts; zero means no cap.
+	MaxDelay time.Duration
+	// Jitter is the fraction, between 0 and 1, of each delay to randomize, so that clients don't retry in lockstep.
+	Jitter float64
+	// RetryableStatuses (Optional) are the status codes to retry; defaults to DefaultRetryableStatuses.
+	RetryableStatuses []int
+	// RetryableError (Optional) reports whether an error without a status code, e.g. a connection reset, should be
+	// retried; defaults to IsTransientError.
+	RetryableError func(err error) bool
+}
+
+// IsTransientError reports whether err is a failure to reach the API, or to read its response, that may succeed
+// if retried: a network timeout, a refused or reset connection, or a connection closed before the response was read.
+//
+// Errors which will fail again, such as TLS certificate errors, unknown hosts and malformed URLs, are not transient;
+// nor are context cancellation and deadlines.
+func IsTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// shouldRetry reports whether err, returned by an attempt, should be retried.
+func (policy *RetryPolicy) shouldRetry(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		statuses := policy.RetryableStatuses
+		if statuses == nil {
+			statuses = DefaultRetryableStatuses
+		}
+		return slices.Contains(statuses, apiErr.StatusCode)
+	}
+	if errors.Is(err, ErrDecode) {
+		return false
+	}
+	if policy.RetryableError != nil {
+		return policy.RetryableError(err)
+	}
+	return IsTransientError(err)
+}
+
+// delay returns how long to wait before the given retry, where retry 1 follows the first attempt.
+func (policy *RetryPolicy) delay(retry int, err error) time.Duration {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter
+	}
+	delay := policy.BaseDelay
+	for i := 1; i < retry && (policy.MaxDelay <= 0 || delay < policy.MaxDelay); i++ {
+		delay *= 2
+	}
+	if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	if jitter := min(max(policy.Jitter, 0), 1); jitter > 0 {
+		delay -= time.Duration(jitter * rand.Float64() * float64(delay))
+	}
+	return delay
+}
+
+// retry calls attempt until it succeeds, returns an error the policy does not retry, or the policy's attempts run out.
+//
+// Each retry is logged to logger.
+//
+// Returns: the error of the last attempt; if the context ends while waiting to retry, the context's error is wrapped too
+func (policy *RetryPolicy) retry(ctx context.Context, logger *slog.Logger, attempt func() error) error {
+	err := attempt()
+	for retry := 1; err != nil && retry < policy.MaxAttempts && policy.shouldRetry(err); retry++ {
+		delay := policy.delay(retry, err)
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			return fmt.Errorf("%w; not retrying, as the context deadline is before the next attempt in %s", err, delay)
+		}
+		logger.InfoContext(ctx, "retrying podcast index API request", "attempt", retry+1, "delay", delay, "error", err)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w; stopped retrying: %w", err, ctx.Err())
+		case <-timer.C:
+		}
+		err = attempt()
+	}
+	return err
+}
+
+// parseRetryAfter parses a Retry-After header, given either as a number of seconds or as an HTTP date.
+//
+// Returns: the delay it asks for, or zero if the header is missing or invalid
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return max(date.Sub(now), 0)
+	}
+	return 0
+}
+
+// RetryMiddleware returns a Middleware retrying failed requests according to policy.
+//
+// Each retry calls the next Handler again, so middlewares after it, and the request's signature, run once per attempt.
+func RetryMiddleware(policy *RetryPolicy) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, exchange *Exchange) error {
+			return policy.retry(ctx, exchange.logger(), func() error {
+				return next(ctx, exchange)
+			})
+		}
+	}
+}
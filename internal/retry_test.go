@@ -0,0 +1,311 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRetryRetryableStatus(t *testing.T) {
+	var authDates []string
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		authDates = append(authDates, r.Header.Get("X-Auth-Date"))
+		if len(authDates) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status": "true"}`))
+	})
+	api.RetryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	clock := time.Unix(1700000000, 0)
+	api.Clock = ClockFunc(func() time.Time {
+		clock = clock.Add(time.Second)
+		return clock
+	})
+
+	var result struct {
+		Status string `json:"status"`
+	}
+	if err := api.Get(context.Background(), "test/retry", nil, &result); err != nil {
+		t.Fatalf("Expected the third attempt to succeed, got %v", err)
+	}
+	if result.Status != "true" {
+		t.Errorf("Expected the successful response to be decoded, got %+v", result)
+	}
+	t.Run("SignsEachAttemptWithAFreshDate", func(t *testing.T) {
+		expected := []string{"1700000001", "1700000002", "1700000003"}
+		if strings.Join(authDates, ",") != strings.Join(expected, ",") {
+			t.Errorf("Expected X-Auth-Date values %v, got %v", expected, authDates)
+		}
+	})
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	attempts := 0
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	api.RetryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	err := api.Get(context.Background(), "test/retry", nil, nil)
+	if !errors.Is(err, ErrRateLimited) {
+		t.Errorf("Expected the last attempt's error, got %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryDoesNotRetryOtherErrors(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statusCode int
+		body       string
+		policy     RetryPolicy
+	}{
+		{name: "BadRequest", statusCode: http.StatusBadRequest},
+		{name: "DecodeError", statusCode: http.StatusOK, body: "not json"},
+		{name: "StatusNotInPolicy", statusCode: http.StatusServiceUnavailable, policy: RetryPolicy{RetryableStatuses: []int{http.StatusTooManyRequests}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			attempts := 0
+			api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte(tc.body))
+			})
+			api.RetryPolicy = &tc.policy
+			api.RetryPolicy.MaxAttempts = 3
+
+			var result struct{}
+			if err := api.Get(context.Background(), "test/retry", nil, &result); err == nil {
+				t.Fatal("Expected an error, got nil")
+			}
+			if attempts != 1 {
+				t.Errorf("Expected 1 attempt, got %d", attempts)
+			}
+		})
+	}
+}
+
+func TestRetryTransientError(t *testing.T) {
+	attempts := 0
+	api := newTestAPI()
+	api.HTTPClient = &http.Client{
+		Transport: &MockRoundTripper{
+			RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+				attempts++
+				return nil, fmt.Errorf("simulated reset: %w", syscall.ECONNRESET)
+			},
+		},
+	}
+	api.RetryPolicy = &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+
+	err := api.Get(context.Background(), "test/retry", nil, nil)
+	if !errors.Is(err, syscall.ECONNRESET) {
+		t.Errorf("Expected the connection reset to be returned, got %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+
+	t.Run("CustomRetryableError", func(t *testing.T) {
+		attempts = 0
+		api.RetryPolicy.RetryableError = func(err error) bool { return false }
+		_ = api.Get(context.Background(), "test/retry", nil, nil)
+		if attempts != 1 {
+			t.Errorf("Expected 1 attempt, got %d", attempts)
+		}
+	})
+}
+
+func TestRetryDoesNotRetryPermanentTransportErrors(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+	}{
+		{name: "UnknownCertificateAuthority", err: x509.UnknownAuthorityError{}},
+		{name: "UnknownHost", err: &net.DNSError{Err: "no such host", Name: "api.example", IsNotFound: true}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			attempts := 0
+			api := newTestAPI()
+			api.HTTPClient = &http.Client{
+				Transport: &MockRoundTripper{
+					RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+						attempts++
+						return nil, tc.err
+					},
+				},
+			}
+			api.RetryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+			if err := api.Get(context.Background(), "test/retry", nil, nil); err == nil {
+				t.Fatal("Expected an error, got nil")
+			}
+			if attempts != 1 {
+				t.Errorf("Expected 1 attempt, got %d", attempts)
+			}
+		})
+	}
+	t.Run("UnsupportedScheme", func(t *testing.T) {
+		attempts := 0
+		api := newTestAPI()
+		api.BaseURL = &url.URL{Scheme: "ftp", Host: "localhost"}
+		api.HTTPClient = &http.Client{
+			Transport: &MockRoundTripper{
+				RoundTripFunc: func(req *http.Request) (*http.Response, error) {
+					attempts++
+					return http.DefaultTransport.RoundTrip(req)
+				},
+			},
+		}
+		api.RetryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+		err := api.Get(context.Background(), "test/retry", nil, nil)
+		if err == nil || !strings.Contains(err.Error(), "unsupported protocol scheme") {
+			t.Errorf("Expected the unsupported scheme to be returned, got %v", err)
+		}
+		if attempts != 1 {
+			t.Errorf("Expected 1 attempt, got %d", attempts)
+		}
+	})
+}
+
+func TestIsTransientError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "ConnectionReset", err: &url.Error{Op: "Get", URL: "http://localhost", Err: syscall.ECONNRESET}, expected: true},
+		{name: "ConnectionRefused", err: &url.Error{Op: "Get", URL: "http://localhost", Err: syscall.ECONNREFUSED}, expected: true},
+		{name: "UnexpectedEOF", err: &url.Error{Op: "Get", URL: "http://localhost", Err: io.ErrUnexpectedEOF}, expected: true},
+		{name: "EOF", err: &url.Error{Op: "Get", URL: "http://localhost", Err: io.EOF}, expected: true},
+		{name: "Timeout", err: &url.Error{Op: "Get", URL: "http://localhost", Err: &net.DNSError{Err: "timeout", IsTimeout: true}}, expected: true},
+		{name: "UnknownCertificateAuthority", err: &url.Error{Op: "Get", URL: "https://localhost", Err: x509.UnknownAuthorityError{}}},
+		{name: "UnknownHost", err: &url.Error{Op: "Get", URL: "http://localhost", Err: &net.DNSError{Err: "no such host", IsNotFound: true}}},
+		{name: "MalformedURL", err: &url.Error{Op: "parse", URL: "http://[::1", Err: errors.New("missing ']' in host")}},
+		{name: "ContextCanceled", err: &url.Error{Op: "Get", URL: "http://localhost", Err: context.Canceled}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsTransientError(tc.err); got != tc.expected {
+				t.Errorf("Expected IsTransientError to be %t for %v, got %t", tc.expected, tc.err, got)
+			}
+		})
+	}
+}
+
+func TestRetryHonorsRetryAfterAndContext(t *testing.T) {
+	attempts := 0
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	api.RetryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	t.Run("DeadlineBeforeRetryAfter", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		start := time.Now()
+		err := api.Get(ctx, "test/retry", nil, nil)
+		if !errors.Is(err, ErrServer) {
+			t.Errorf("Expected the attempt's error, got %v", err)
+		}
+		if time.Since(start) > time.Second {
+			t.Errorf("Expected not to wait for a retry past the deadline")
+		}
+		if attempts != 1 {
+			t.Errorf("Expected 1 attempt, got %d", attempts)
+		}
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		attempts = 0
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+		err := api.Get(ctx, "test/retry", nil, nil)
+		if !errors.Is(err, context.Canceled) || !errors.Is(err, ErrServer) {
+			t.Errorf("Expected the attempt's error and the context's error, got %v", err)
+		}
+		if attempts != 1 {
+			t.Errorf("Expected 1 attempt, got %d", attempts)
+		}
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	for retry, expected := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second, 40: 5 * time.Second} {
+		if delay := policy.delay(retry, errors.New("test")); delay != expected {
+			t.Errorf("Expected retry %d to wait %s, got %s", retry, expected, delay)
+		}
+	}
+
+	t.Run("Jitter", func(t *testing.T) {
+		policy.Jitter = 0.5
+		for range 100 {
+			if delay := policy.delay(1, errors.New("test")); delay < 500*time.Millisecond || delay > time.Second {
+				t.Fatalf("Expected a delay between 500ms and 1s, got %s", delay)
+			}
+		}
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]time.Duration{
+		"":                              0,
+		"120":                           2 * time.Minute,
+		"-5":                            0,
+		"soon":                          0,
+		"Wed, 01 Jan 2025 00:00:30 GMT": 30 * time.Second,
+		"Tue, 31 Dec 2024 23:00:00 GMT": 0,
+	}
+	for header, expected := range testCases {
+		if actual := parseRetryAfter(header, now); actual != expected {
+			t.Errorf("Expected Retry-After %q to parse as %s, got %s", header, expected, actual)
+		}
+	}
+}
+
+func TestRetryIsLogged(t *testing.T) {
+	attempts := 0
+	api, _ := setupTestAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+	var logs bytes.Buffer
+	api.Logger = slog.New(slog.NewTextHandler(&logs, nil))
+	api.RetryPolicy = &RetryPolicy{MaxAttempts: 2}
+
+	var result struct{}
+	if err := api.Get(context.Background(), "/retried", nil, &result); err != nil {
+		t.Fatalf("Expected the retry to succeed, got %v", err)
+	}
+	for _, expected := range []string{"retrying podcast index API request", "endpoint=/retried", "attempt=2"} {
+		if !strings.Contains(logs.String(), expected) {
+			t.Errorf("Expected the retry to be logged with %q, got %s", expected, logs.String())
+		}
+	}
+}
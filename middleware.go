@@ -0,0 +1,68 @@
+package podcastindex
+
+import (
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/internal"
+)
+
+// Exchange is a single request to the PodcastIndex API, and its response, as it passes through the middleware chain.
+//
+// It holds the endpoint and params of the request, the signed *http.Request once sent, the *http.Response and its
+// body, and when the request was sent and how long it took. Middlewares may change the request fields, e.g. Params
+// or Header, before calling the next Handler, and read the response fields after it returns.
+type Exchange = internal.Exchange
+
+// Handler handles an Exchange, setting its ResponseBody, or returning an error.
+type Handler = internal.Handler
+
+// Middleware wraps every request made by a Client; set it with NewClientOptions.Middlewares.
+//
+// A Middleware can log, measure or add headers to requests, or short-circuit them, e.g. to inject faults,
+// by returning without calling next. For example, to log the duration of each request:
+//
+//	func logRequests(next podcastindex.Handler) podcastindex.Handler {
+//		return func(ctx context.Context, exchange *podcastindex.Exchange) error {
+//			start := time.Now()
+//			err := next(ctx, exchange)
+//			log.Printf("%s %s took %s: %v", exchange.Method, exchange.Endpoint, time.Since(start), err)
+//			return err
+//		}
+//	}
+type Middleware = internal.Middleware
+
+// Chain wraps handler in middlewares, so that the first middleware is the outermost, and sees each request first.
+func Chain(handler Handler, middlewares ...Middleware) Handler {
+	return internal.Chain(handler, middlewares...)
+}
+
+// RetryMiddleware returns a Middleware retrying failed requests according to policy; it is what
+// NewClientOptions.RetryPolicy installs. Middlewares after it, and the request's signature, run once per attempt.
+func RetryMiddleware(policy *RetryPolicy) Middleware {
+	return internal.RetryMiddleware(policy)
+}
+
+// RateLimitMiddleware returns a Middleware making each request wait for limiter before it is sent; it is what
+// NewClientOptions.RateLimiter installs.
+func RateLimitMiddleware(limiter *RateLimiter) Middleware {
+	return internal.RateLimitMiddleware(limiter)
+}
+
+// CacheMiddleware returns a Middleware serving requests from cache, and caching their responses for as long as
+// ttls configures; it is what NewClientOptions.Cache installs.
+func CacheMiddleware(cache Cache, ttls CacheTTLs) Middleware {
+	return internal.CacheMiddleware(cache, func(endpoint string) time.Duration {
+		return ttls.ttl(endpoint)
+	})
+}
+
+// CoalesceMiddleware returns a Middleware coalescing identical concurrent requests, i.e. with the same endpoint,
+// params and extra headers, so they share a single round trip; it is what NewClientOptions.CoalesceRequests installs.
+//
+// Each caller gets its own copy of the shared response and error, so changes to one caller's result never affect
+// another's. The shared request is made with the logger and context values of the caller which started it.
+// Cancelling one caller's context only stops that caller waiting; the shared request is cancelled once no callers
+// are waiting for it.
+func CoalesceMiddleware() Middleware {
+	return internal.CoalesceMiddleware()
+}
@@ -0,0 +1,57 @@
+package podcastindex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestClientMiddlewares(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status": "true", "feeds": []}`))
+	}))
+	t.Cleanup(server.Close)
+	serverURL, _ := url.Parse(server.URL)
+
+	var statuses []int
+	recordStatuses := func(next Handler) Handler {
+		return func(ctx context.Context, exchange *Exchange) error {
+			err := next(ctx, exchange)
+			if exchange.Response != nil {
+				statuses = append(statuses, exchange.Response.StatusCode)
+			}
+			return err
+		}
+	}
+	// the built-in middlewares may be placed explicitly, here so recordStatuses sees every attempt,
+	// and cached responses skip the retries.
+	client := NewClient(NewClientOptions{
+		UserAgent: "podcastindex-go/testrunner",
+		BaseURL:   serverURL,
+		Middlewares: []Middleware{
+			CacheMiddleware(NewMemoryCache(10), DefaultCacheTTLs()),
+			RetryMiddleware(&RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}),
+			recordStatuses,
+		},
+	})
+	for range 2 {
+		if _, err := client.Categories(context.Background()); err != nil {
+			t.Fatalf("expected the request to succeed, got %v", err)
+		}
+	}
+	if len(statuses) != 2 || statuses[0] != http.StatusServiceUnavailable || statuses[1] != http.StatusOK {
+		t.Errorf("expected the middleware to see a 503 then a 200, got %v", statuses)
+	}
+	if attempts != 2 {
+		t.Errorf("expected the second call to be served from the cache, got %d attempts", attempts)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/jjgmckenzie/podcastindex/internal"
 	"net/url"
+	"reflect"
 
 	"github.com/jjgmckenzie/podcastindex/podcast"
 	"strconv"
@@ -128,6 +129,9 @@ type Podcast struct {
 	NewestItemPubDate *time.Time
 	// Value is the "Value for Value" payment information for the podcast. Will be nil if not reported.
 	Value *podcast.Value
+	// Extra holds the fields of the podcast's JSON which this library does not model, e.g. fields added to the API
+	// since, keyed by name. They are marshalled again along with the podcast; decode one with ExtraField.
+	Extra map[string]json.RawMessage
 }
 
 // podcastJSON is an intermediary struct used for unmarshalling Podcast data,
@@ -167,9 +171,13 @@ type podcastJSON struct {
 	Locked                 int               `json:"locked"`
 	ImageURLHash           int               `json:"imageUrlHash"`
 	NewestItemPubDate      int64             `json:"newestItemPubdate"`
+	NewestItemPublishTime  int64             `json:"newestItemPublishTime,omitempty"`
 	Value                  *podcast.Value    `json:"value,omitempty"`
 }
 
+// podcastJSONFields are the names of the fields of podcastJSON; any other fields are kept in Podcast.Extra.
+var podcastJSONFields = internal.JSONFieldNames(reflect.TypeFor[podcastJSON]())
+
 // UnmarshalJSON implements the json.Unmarshaler interface for Podcast.
 // It handles the conversion of Unix timestamps (int64) to time.Time,
 // as well as converting category IDs to ints, mistyped booleans, and parsing URLs.
@@ -178,6 +186,11 @@ func (p *Podcast) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &aux); err != nil {
 		return err
 	}
+	extra, err := internal.UnknownFields(data, podcastJSONFields)
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
 
 	// --- Direct field assignments & simple conversions ---
 	p.ID = podcast.ID(aux.ID)
@@ -210,7 +223,6 @@ func (p *Podcast) UnmarshalJSON(data []byte) error {
 	p.Value = aux.Value // Assign pointer directly
 
 	// --- URL parsing ---
-	var err error
 	var parsedURL *url.URL
 
 	// Handle explicit field which can be boolean or integer
@@ -265,6 +277,10 @@ func (p *Podcast) UnmarshalJSON(data []byte) error {
 	p.LastCrawlTime = time.Unix(aux.LastCrawlTime, 0)
 	p.LastParseTime = time.Unix(aux.LastParseTime, 0)
 	p.LastGoodHTTPStatusTime = time.Unix(aux.LastGoodHTTPStatusTime, 0)
+	if aux.NewestItemPubDate == 0 {
+		// some endpoints (e.g. recent/feeds) report newestItemPublishTime instead; see NewestItemPubDate
+		aux.NewestItemPubDate = aux.NewestItemPublishTime
+	}
 	newestItemPubDateTime := time.Unix(aux.NewestItemPubDate, 0)
 	p.NewestItemPubDate = &newestItemPubDateTime
 
@@ -339,7 +355,11 @@ func (p *Podcast) MarshalJSON() ([]byte, error) {
 		aux.Categories = categories
 	}
 
-	return json.Marshal(aux)
+	encoded, err := json.Marshal(aux)
+	if err != nil {
+		return nil, err
+	}
+	return internal.AppendFields(encoded, p.Extra, podcastJSONFields)
 }
 
 // Helper function to parse the explicit field which can be boolean or integer
@@ -2,6 +2,11 @@ package podcast
 
 type CategoryID int
 
+// FilterID returns the category ID itself; it implements CategoryFilter.
+func (id CategoryID) FilterID() CategoryID {
+	return id
+}
+
 // Category is a category of a podcast.
 //
 // https://podcastindex-org.github.io/docs-api/#tag--Categories
@@ -11,3 +16,16 @@ type Category struct {
 	// Name is the name of the category.
 	Name string `json:"name"`
 }
+
+// FilterID returns the ID of the category; it implements CategoryFilter.
+func (c Category) FilterID() CategoryID {
+	return c.ID
+}
+
+// CategoryFilter is a category used to include or exclude results by category.
+//
+// It is implemented by both CategoryID and Category, so either can be used in a filter.
+type CategoryFilter interface {
+	// FilterID returns the ID of the category to filter by
+	FilterID() CategoryID
+}
@@ -0,0 +1,13 @@
+package podcast
+
+import "testing"
+
+func TestCategoryFilter(t *testing.T) {
+	filters := []CategoryFilter{CategoryID(9), Category{ID: 11, Name: "Careers"}}
+	expected := []CategoryID{9, 11}
+	for i, filter := range filters {
+		if filter.FilterID() != expected[i] {
+			t.Errorf("expected filter ID %d, got %d", expected[i], filter.FilterID())
+		}
+	}
+}
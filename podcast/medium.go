@@ -0,0 +1,30 @@
+package podcast
+
+// Medium is the value of the podcast:medium tag, which tells an application what the content contained within the feed is.
+//
+// See the podcast namespace spec for more information.
+// https://github.com/Podcastindex-org/podcast-namespace/blob/main/docs/1.0.md#medium
+type Medium string
+
+const (
+	MediumPodcast    Medium = "podcast"
+	MediumMusic      Medium = "music"
+	MediumVideo      Medium = "video"
+	MediumFilm       Medium = "film"
+	MediumAudiobook  Medium = "audiobook"
+	MediumNewsletter Medium = "newsletter"
+	MediumBlog       Medium = "blog"
+	MediumPublisher  Medium = "publisher"
+	MediumCourse     Medium = "course"
+	// The "L" suffixed mediums are lists of feeds of the corresponding medium, rather than the content itself.
+	MediumPodcastList    Medium = "podcastL"
+	MediumMusicList      Medium = "musicL"
+	MediumVideoList      Medium = "videoL"
+	MediumFilmList       Medium = "filmL"
+	MediumAudiobookList  Medium = "audiobookL"
+	MediumNewsletterList Medium = "newsletterL"
+	MediumBlogList       Medium = "blogL"
+	MediumPublisherList  Medium = "publisherL"
+	MediumCourseList     Medium = "courseL"
+	MediumMixed          Medium = "mixed"
+)
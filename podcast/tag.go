@@ -0,0 +1,11 @@
+package podcast
+
+// Tag is a podcast namespace tag which feeds can be looked up by.
+type Tag string
+
+const (
+	// TagValue is the podcast:value tag; see https://github.com/Podcastindex-org/podcast-namespace/blob/main/docs/1.0.md#value
+	TagValue Tag = "podcast-value"
+	// TagValueTimeSplit is the podcast:valueTimeSplit tag; see https://github.com/Podcastindex-org/podcast-namespace/blob/main/docs/1.0.md#value-time-split
+	TagValueTimeSplit Tag = "podcast-valueTimeSplit"
+)
@@ -0,0 +1,87 @@
+package podcastindex
+
+import (
+	"context"
+	"iter"
+	"net/url"
+
+	"github.com/jjgmckenzie/podcastindex/episode"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// PodcastIndex is the interface of Client: every method of the client which calls the PodcastIndex API.
+//
+// Depend on PodcastIndex, rather than *Client, to substitute a fake in tests, e.g. podcastindextest.Stub; see Client
+// for the documentation of each method.
+type PodcastIndex interface {
+	// Search
+	SearchPodcastsByTerm(ctx context.Context, term string, params *SearchPodcastsByTermParams) ([]*Podcast, error)
+	SearchPodcastsByTitle(ctx context.Context, title string, params *SearchPodcastsByTitleParams) ([]*Podcast, error)
+	SearchPodcastsByPerson(ctx context.Context, person string, params *SearchPodcastsByPersonParams) ([]*Podcast, error)
+	SearchMusicPodcastsByTerm(ctx context.Context, title string, params *SearchMusicPodcastsByTermParams) ([]*Podcast, error)
+
+	// Podcasts
+	GetPodcastByFeedID(ctx context.Context, feedID podcast.ID) (*Podcast, error)
+	GetPodcastByURL(ctx context.Context, feedURL url.URL) (*Podcast, error)
+	GetPodcastByGUID(ctx context.Context, guid podcast.GUID) (*Podcast, error)
+	GetPodcastByITunesID(ctx context.Context, itunesID podcast.ITunesID) (*Podcast, error)
+	GetPodcastsByGUIDs(ctx context.Context, guids []podcast.GUID) (map[podcast.GUID]*Podcast, []podcast.GUID, error)
+	PodcastsByTag(ctx context.Context, tag podcast.Tag, params *PodcastsByTagParams) ([]*Podcast, int, error)
+	AllPodcastsByTag(ctx context.Context, tag podcast.Tag, params *PodcastsByTagParams) iter.Seq2[*Podcast, error]
+	PodcastsByMedium(ctx context.Context, medium podcast.Medium, params *PodcastsByMediumParams) ([]*Podcast, error)
+	TrendingPodcasts(ctx context.Context, params *TrendingPodcastsParams) ([]*Podcast, error)
+	DeadPodcasts(ctx context.Context) ([]*Podcast, error)
+
+	// Episodes
+	GetEpisodes(ctx context.Context, podcast Podcast, params *GetEpisodesParams) (*[]Episode, error)
+	GetEpisodesByFeedID(ctx context.Context, feedID podcast.ID, params *GetEpisodesParams) (*[]Episode, error)
+	GetEpisodesByFeedURL(ctx context.Context, feedURL url.URL, params *GetEpisodesParams) (*[]Episode, error)
+	GetEpisodesByITunesID(ctx context.Context, itunesID podcast.ITunesID, params *GetEpisodesParams) (*[]Episode, error)
+	GetEpisodesByPodcastGUID(ctx context.Context, guid podcast.GUID, params *GetEpisodesParams) (*[]Episode, error)
+	GetEpisodeByID(ctx context.Context, feedID episode.ID) (*Episode, error)
+	GetEpisodeByGUID(ctx context.Context, guid episode.GUID, scope EpisodeGUIDScope, params *GetEpisodesParams) (*Episode, error)
+	GetLiveEpisodes(ctx context.Context, params *LiveEpisodesParams) (*[]Episode, error)
+	RandomEpisodes(ctx context.Context, params *RandomEpisodesParams) (*[]Episode, error)
+	AllEpisodesByFeedID(ctx context.Context, feedID podcast.ID, params *GetEpisodesParams, cursor *EpisodeCursor) iter.Seq2[Episode, error]
+
+	// Recent
+	RecentEpisodes(ctx context.Context, params *RecentEpisodesParams) (*[]Episode, error)
+	AllRecentEpisodes(ctx context.Context, params *RecentEpisodesParams, cursor *EpisodeCursor) iter.Seq2[Episode, error]
+	RecentFeeds(ctx context.Context, params *RecentFeedsParams) ([]*Podcast, error)
+	RecentNewFeeds(ctx context.Context, params *RecentNewFeedsParams) ([]*Podcast, error)
+	RecentNewValueFeeds(ctx context.Context, params *RecentNewValueFeedsParams) ([]*Podcast, error)
+	RecentSoundbites(ctx context.Context, params *RecentSoundbitesParams) ([]RecentSoundbite, error)
+	RecentData(ctx context.Context, params *RecentDataParams) (*RecentData, error)
+
+	// Value
+	GetValueByFeedID(ctx context.Context, feedID podcast.ID) (*PodcastValue, error)
+	GetValueByITunesID(ctx context.Context, itunesID podcast.ITunesID) (*PodcastValue, error)
+	GetValueByPodcastGUID(ctx context.Context, guid podcast.GUID) (*PodcastValue, error)
+	GetValueByEpisodeGUID(ctx context.Context, podcastGUID podcast.GUID, episodeGUID episode.GUID) (*PodcastValue, error)
+	GetValuesByEpisodeGUIDs(ctx context.Context, guids map[podcast.GUID][]episode.GUID) ([]PodcastValue, error)
+
+	// Bulk lookups
+	GetPodcastsByFeedIDs(ctx context.Context, feedIDs []podcast.ID, params *BulkParams) []BulkResult[podcast.ID, *Podcast]
+	StreamPodcastsByFeedIDs(ctx context.Context, feedIDs []podcast.ID, params *BulkParams) iter.Seq[BulkResult[podcast.ID, *Podcast]]
+	GetPodcastsByITunesIDs(ctx context.Context, itunesIDs []podcast.ITunesID, params *BulkParams) []BulkResult[podcast.ITunesID, *Podcast]
+	StreamPodcastsByITunesIDs(ctx context.Context, itunesIDs []podcast.ITunesID, params *BulkParams) iter.Seq[BulkResult[podcast.ITunesID, *Podcast]]
+	GetEpisodesByIDs(ctx context.Context, episodeIDs []episode.ID, params *BulkParams) []BulkResult[episode.ID, *Episode]
+	StreamEpisodesByIDs(ctx context.Context, episodeIDs []episode.ID, params *BulkParams) iter.Seq[BulkResult[episode.ID, *Episode]]
+
+	// Apple replacement
+	AppleSearch(ctx context.Context, term string, params *AppleSearchParams) ([]AppleResult, error)
+	AppleLookup(ctx context.Context, itunesID podcast.ITunesID) ([]AppleResult, error)
+
+	// Categories and stats
+	Categories(ctx context.Context) ([]podcast.Category, error)
+	CurrentStats(ctx context.Context) (*Stats, error)
+	DailyCounts(ctx context.Context) (map[string]int64, error)
+
+	// Adding podcasts and notifying the index
+	AddPodcastByFeedURL(ctx context.Context, feedURL url.URL, params *AddPodcastByFeedURLParams) (*AddPodcastResult, error)
+	AddPodcastByITunesID(ctx context.Context, itunesID podcast.ITunesID) (*AddPodcastResult, error)
+	NotifyFeedChanged(ctx context.Context, params NotifyFeedChangedParams) (*NotifyFeedChangedResult, error)
+}
+
+// Client implements PodcastIndex; this fails to compile if a method of the interface is missing from Client.
+var _ PodcastIndex = (*Client)(nil)
@@ -0,0 +1,17 @@
+package podcastindex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPodcastIndexCoversClient(t *testing.T) {
+	client := reflect.TypeFor[*Client]()
+	podcastIndex := reflect.TypeFor[PodcastIndex]()
+	for i := range client.NumMethod() {
+		method := client.Method(i)
+		if _, ok := podcastIndex.MethodByName(method.Name); !ok {
+			t.Errorf("Client.%s is missing from the PodcastIndex interface", method.Name)
+		}
+	}
+}
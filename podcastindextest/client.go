@@ -0,0 +1,45 @@
+package podcastindextest
+
+import (
+	"testing"
+
+	"github.com/jjgmckenzie/podcastindex"
+)
+
+// NewTestRecorder creates a Recorder for the cassette file at path, in the Mode given by ModeFromEnv, for a test.
+//
+// The test fails if the cassette cannot be loaded, or a request cannot be replayed. When recording, the cassette is
+// saved when the test finishes.
+func NewTestRecorder(t testing.TB, path string) *Recorder {
+	t.Helper()
+	recorder, err := NewRecorder(path, ModeFromEnv(), nil)
+	if err != nil {
+		t.Fatalf("podcastindextest: %v", err)
+	}
+	recorder.t = t
+	t.Cleanup(func() {
+		if err := recorder.Save(); err != nil {
+			t.Errorf("podcastindextest: %v", err)
+		}
+	})
+	return recorder
+}
+
+// NewClient creates a podcastindex.Client for a test, sending its requests through a Recorder for the cassette file
+// at path; see NewTestRecorder.
+//
+// When recording, the client is signed with the credentials in the environment, see podcastindex.EnvCredentials,
+// unless options sets credentials. When replaying, no credentials are needed. The user agent defaults to
+// podcastindex-go/podcastindextest.
+func NewClient(t testing.TB, path string, options podcastindex.NewClientOptions) *podcastindex.Client {
+	t.Helper()
+	recorder := NewTestRecorder(t, path)
+	options.HTTPClient = recorder.Client()
+	if options.UserAgent == "" {
+		options.UserAgent = "podcastindex-go/podcastindextest"
+	}
+	if recorder.Mode() == ModeRecord && options.APIKey == "" && options.Credentials == nil {
+		options.Credentials = podcastindex.EnvCredentials{}
+	}
+	return podcastindex.NewClient(options)
+}
@@ -0,0 +1,292 @@
+// Package podcastindextest provides utilities for testing code which uses the podcastindex package,
+// without live credentials or hand-written servers.
+package podcastindextest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Mode is whether a Recorder records real requests, or replays recorded ones.
+type Mode int
+
+const (
+	// ModeReplay answers requests with the responses recorded in the cassette, without sending them.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests, and records them and their responses to the cassette.
+	ModeRecord
+)
+
+// RecordEnv is the environment variable which, when set to "1" or "true", makes ModeFromEnv return ModeRecord.
+const RecordEnv = "PODCASTINDEX_RECORD"
+
+// Redacted replaces the value of redacted headers in cassettes.
+const Redacted = "REDACTED"
+
+// ErrUnmatchedRequest is wrapped by the error returned when replaying a request which has no recorded interaction.
+var ErrUnmatchedRequest = errors.New("no recorded interaction matches request")
+
+// redactedHeaders are the headers whose values are never written to cassettes, as they hold credentials.
+var redactedHeaders = []string{"Authorization", "X-Auth-Key", "X-Auth-Date", "Cookie", "Set-Cookie"}
+
+// ModeFromEnv returns ModeRecord if the RecordEnv environment variable is "1" or "true", and ModeReplay otherwise.
+func ModeFromEnv() Mode {
+	switch strings.ToLower(os.Getenv(RecordEnv)) {
+	case "1", "true":
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// Cassette is a recording of requests to the PodcastIndex API, and their responses.
+type Cassette struct {
+	// Interactions are the requests and responses, in the order they were recorded.
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request as recorded in a Cassette, with its credentials redacted.
+type RecordedRequest struct {
+	// Method is the HTTP method of the request, e.g. GET.
+	Method string `json:"method"`
+	// Path is the path of the request URL, e.g. /api/1.0/podcasts/byfeedid
+	Path string `json:"path"`
+	// Query is the canonical query of the request URL, with its parameters sorted by key.
+	Query string `json:"query"`
+	// Header are the headers of the request, with credentials redacted.
+	Header http.Header `json:"header,omitempty"`
+	// Body is the body of the request, if any.
+	Body string `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response as recorded in a Cassette.
+type RecordedResponse struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"statusCode"`
+	// Header are the headers of the response, with cookies redacted.
+	Header http.Header `json:"header,omitempty"`
+	// Body is the body of the response.
+	Body string `json:"body"`
+}
+
+// matches reports whether a request has the same method, path and canonical query as the recorded one.
+func (recorded RecordedRequest) matches(req *http.Request) bool {
+	return recorded.Method == req.Method && recorded.Path == req.URL.Path && recorded.Query == canonicalQuery(req)
+}
+
+// canonicalQuery returns the query of a request's URL, with its parameters sorted by key.
+func canonicalQuery(req *http.Request) string {
+	return req.URL.Query().Encode()
+}
+
+// redact returns a copy of header with the values of the redactedHeaders replaced.
+func redact(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, name := range redactedHeaders {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, Redacted)
+		}
+	}
+	return redacted
+}
+
+// Recorder is an http.RoundTripper recording requests to, and responses from, the PodcastIndex API to a cassette
+// file, or replaying them from it.
+//
+// When replaying, requests are matched to recorded interactions on their method, path and canonical query. Identical
+// requests are answered with the matching interactions in the order they were recorded, then with the last one again.
+// A request without a match fails with an error wrapping ErrUnmatchedRequest, and fails the test, if any.
+type Recorder struct {
+	mode Mode
+	path string
+	// transport sends requests when recording.
+	transport http.RoundTripper
+	// t (Optional) is failed when a request cannot be replayed.
+	t interface {
+		Helper()
+		Errorf(format string, args ...any)
+	}
+
+	mu       sync.Mutex
+	cassette Cassette
+	// replayed counts how many times each interaction has been replayed.
+	replayed []int
+}
+
+var _ http.RoundTripper = &Recorder{}
+
+// NewRecorder creates a Recorder for the cassette file at path.
+//
+// In ModeReplay, the cassette is loaded from path. In ModeRecord, requests are sent with transport, which defaults to
+// http.DefaultTransport, and the cassette is written to path by Save.
+//
+// Returns: the Recorder, or an error if the cassette cannot be loaded
+func NewRecorder(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	recorder := &Recorder{mode: mode, path: path, transport: transport}
+	if mode == ModeReplay {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load cassette, record it with %s=1: %w", RecordEnv, err)
+		}
+		if err := json.Unmarshal(contents, &recorder.cassette); err != nil {
+			return nil, fmt.Errorf("failed to decode cassette %s: %w", path, err)
+		}
+		recorder.replayed = make([]int, len(recorder.cassette.Interactions))
+	}
+	return recorder, nil
+}
+
+// Mode returns whether the Recorder is recording or replaying.
+func (recorder *Recorder) Mode() Mode {
+	return recorder.mode
+}
+
+// Client returns an *http.Client sending its requests through the Recorder, for NewClientOptions.HTTPClient.
+func (recorder *Recorder) Client() *http.Client {
+	return &http.Client{Transport: recorder}
+}
+
+// RoundTrip records or replays a request, according to the Recorder's Mode.
+func (recorder *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if recorder.mode == ModeRecord {
+		return recorder.record(req)
+	}
+	return recorder.replay(req)
+}
+
+// record sends a request, and records it and its response.
+func (recorder *Recorder) record(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil {
+		var err error
+		if requestBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+	resp, err := recorder.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.cassette.Interactions = append(recorder.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  canonicalQuery(req),
+			Header: redact(req.Header),
+			Body:   string(requestBody),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     redact(resp.Header),
+			Body:       string(responseBody),
+		},
+	})
+	return resp, nil
+}
+
+// replay answers a request with the response of the matching recorded interaction.
+func (recorder *Recorder) replay(req *http.Request) (*http.Response, error) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	match := -1
+	for i, interaction := range recorder.cassette.Interactions {
+		if !interaction.Request.matches(req) {
+			continue
+		}
+		match = i
+		if recorder.replayed[i] == 0 {
+			break
+		}
+	}
+	if match == -1 {
+		err := fmt.Errorf("%w: %s %s?%s in cassette %s", ErrUnmatchedRequest, req.Method, req.URL.Path, canonicalQuery(req), recorder.path)
+		if recorder.t != nil {
+			recorder.t.Helper()
+			recorder.t.Errorf("%v", err)
+		}
+		return nil, err
+	}
+	recorder.replayed[match]++
+	recorded := recorder.cassette.Interactions[match].Response
+	header := recorded.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+// Unreplayed returns the recorded interactions which have not been replayed, e.g. to check a test made every
+// request it was recorded making.
+func (recorder *Recorder) Unreplayed() []Interaction {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	var unreplayed []Interaction
+	for i, count := range recorder.replayed {
+		if count == 0 {
+			unreplayed = append(unreplayed, recorder.cassette.Interactions[i])
+		}
+	}
+	return unreplayed
+}
+
+// Save writes the recorded cassette to the Recorder's path, creating its directory if needed.
+// It does nothing when replaying.
+//
+// Returns: an error if the cassette cannot be written
+func (recorder *Recorder) Save() error {
+	if recorder.mode != ModeRecord {
+		return nil
+	}
+	recorder.mu.Lock()
+	cassette := Cassette{Interactions: slices.Clone(recorder.cassette.Interactions)}
+	recorder.mu.Unlock()
+	contents, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(recorder.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+	if err := os.WriteFile(recorder.path, append(contents, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
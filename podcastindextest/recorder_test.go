@@ -0,0 +1,142 @@
+package podcastindextest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jjgmckenzie/podcastindex"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// fakeT records the errors reported by a Recorder.
+type fakeT struct {
+	errors []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func newClient(serverURL string, httpClient *http.Client) *podcastindex.Client {
+	baseURL, _ := url.Parse(serverURL)
+	return podcastindex.NewClient(podcastindex.NewClientOptions{
+		UserAgent:  "podcastindex-go/testrunner",
+		APIKey:     "SECRETKEY",
+		APISecret:  "SECRETSECRET",
+		BaseURL:    baseURL.JoinPath("/api/1.0/"),
+		HTTPClient: httpClient,
+	})
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = fmt.Fprintf(w, `{"status": "true", "feed": {"id": %s, "title": "Podcast %d"}}`, r.URL.Query().Get("id"), requests)
+	}))
+	path := filepath.Join(t.TempDir(), "cassettes", "podcasts.json")
+
+	recorder, err := NewRecorder(path, ModeRecord, server.Client().Transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := newClient(server.URL, recorder.Client())
+	for _, feedID := range []podcast.ID{75075, 75075, 920666} {
+		if _, err := client.GetPodcastByFeedID(context.Background(), feedID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+	server.Close()
+
+	t.Run("redacts credentials", func(t *testing.T) {
+		contents, _ := os.ReadFile(path)
+		if strings.Contains(string(contents), "SECRETKEY") {
+			t.Errorf("expected the API key to be redacted, got %s", contents)
+		}
+		var cassette Cassette
+		if err := json.Unmarshal(contents, &cassette); err != nil || len(cassette.Interactions) != 3 {
+			t.Fatalf("expected a cassette with 3 interactions, got %v", err)
+		}
+		for _, header := range []string{"Authorization", "X-Auth-Key", "X-Auth-Date"} {
+			if value := cassette.Interactions[0].Request.Header.Get(header); value != Redacted {
+				t.Errorf("expected %s to be redacted, got %q", header, value)
+			}
+		}
+	})
+
+	replayer, err := NewRecorder(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = newClient(server.URL, replayer.Client())
+
+	t.Run("replays recorded responses in order", func(t *testing.T) {
+		var titles []string
+		for _, feedID := range []podcast.ID{75075, 75075, 75075, 920666} {
+			p, err := client.GetPodcastByFeedID(context.Background(), feedID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			titles = append(titles, p.Title)
+		}
+		if strings.Join(titles, ",") != "Podcast 1,Podcast 2,Podcast 2,Podcast 3" {
+			t.Errorf("expected the recorded titles, got %v", titles)
+		}
+		if len(replayer.Unreplayed()) != 0 {
+			t.Errorf("expected every interaction to be replayed, got %v", replayer.Unreplayed())
+		}
+	})
+	t.Run("fails loudly on unmatched requests", func(t *testing.T) {
+		fake := &fakeT{}
+		replayer.t = fake
+		_, err := client.GetPodcastByFeedID(context.Background(), 1)
+		if !errors.Is(err, ErrUnmatchedRequest) {
+			t.Errorf("expected ErrUnmatchedRequest, got %v", err)
+		}
+		if len(fake.errors) != 1 || !strings.Contains(fake.errors[0], "/api/1.0/podcasts/byfeedid?id=1") {
+			t.Errorf("expected the test to fail with the unmatched request, got %v", fake.errors)
+		}
+	})
+}
+
+func TestNewRecorderMissingCassette(t *testing.T) {
+	_, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), ModeReplay, nil)
+	if err == nil || !strings.Contains(err.Error(), RecordEnv) {
+		t.Errorf("expected an error explaining how to record the cassette, got %v", err)
+	}
+}
+
+func TestModeFromEnv(t *testing.T) {
+	for value, expected := range map[string]Mode{"": ModeReplay, "0": ModeReplay, "1": ModeRecord, "true": ModeRecord} {
+		t.Setenv(RecordEnv, value)
+		if mode := ModeFromEnv(); mode != expected {
+			t.Errorf("expected %q to be mode %d, got %d", value, expected, mode)
+		}
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	t.Setenv(RecordEnv, "")
+	client := NewClient(t, filepath.Join("testdata", "categories.json"), podcastindex.NewClientOptions{})
+	categories, err := client.Categories(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(categories) != 2 || categories[1].Name != "Books" {
+		t.Errorf("expected the recorded categories, got %v", categories)
+	}
+}
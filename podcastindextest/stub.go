@@ -0,0 +1,590 @@
+package podcastindextest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"slices"
+	"sync"
+
+	"github.com/jjgmckenzie/podcastindex"
+	"github.com/jjgmckenzie/podcastindex/episode"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// ErrNotStubbed is wrapped by the error returned by a Stub method whose function field is not set.
+var ErrNotStubbed = errors.New("podcastindextest: method not stubbed")
+
+// Stub is a podcastindex.PodcastIndex for tests, whose methods call its function fields, e.g. SearchPodcastsByTermFunc
+// for SearchPodcastsByTerm, and record their calls.
+//
+// Set the function fields of the methods a test calls; the other methods return an error wrapping ErrNotStubbed.
+// A Stub is safe for concurrent use, as long as its function fields are not changed while it is in use.
+//
+//	stub := &podcastindextest.Stub{
+//		SearchPodcastsByTermFunc: func(ctx context.Context, term string, params *podcastindex.SearchPodcastsByTermParams) ([]*podcastindex.Podcast, error) {
+//			return []*podcastindex.Podcast{{Title: "Batman University"}}, nil
+//		},
+//	}
+type Stub struct {
+	SearchPodcastsByTermFunc      func(ctx context.Context, term string, params *podcastindex.SearchPodcastsByTermParams) ([]*podcastindex.Podcast, error)
+	SearchPodcastsByTitleFunc     func(ctx context.Context, title string, params *podcastindex.SearchPodcastsByTitleParams) ([]*podcastindex.Podcast, error)
+	SearchPodcastsByPersonFunc    func(ctx context.Context, person string, params *podcastindex.SearchPodcastsByPersonParams) ([]*podcastindex.Podcast, error)
+	SearchMusicPodcastsByTermFunc func(ctx context.Context, title string, params *podcastindex.SearchMusicPodcastsByTermParams) ([]*podcastindex.Podcast, error)
+	GetPodcastByFeedIDFunc        func(ctx context.Context, feedID podcast.ID) (*podcastindex.Podcast, error)
+	GetPodcastByURLFunc           func(ctx context.Context, feedURL url.URL) (*podcastindex.Podcast, error)
+	GetPodcastByGUIDFunc          func(ctx context.Context, guid podcast.GUID) (*podcastindex.Podcast, error)
+	GetPodcastByITunesIDFunc      func(ctx context.Context, itunesID podcast.ITunesID) (*podcastindex.Podcast, error)
+	GetPodcastsByGUIDsFunc        func(ctx context.Context, guids []podcast.GUID) (map[podcast.GUID]*podcastindex.Podcast, []podcast.GUID, error)
+	PodcastsByTagFunc             func(ctx context.Context, tag podcast.Tag, params *podcastindex.PodcastsByTagParams) ([]*podcastindex.Podcast, int, error)
+	AllPodcastsByTagFunc          func(ctx context.Context, tag podcast.Tag, params *podcastindex.PodcastsByTagParams) iter.Seq2[*podcastindex.Podcast, error]
+	PodcastsByMediumFunc          func(ctx context.Context, medium podcast.Medium, params *podcastindex.PodcastsByMediumParams) ([]*podcastindex.Podcast, error)
+	TrendingPodcastsFunc          func(ctx context.Context, params *podcastindex.TrendingPodcastsParams) ([]*podcastindex.Podcast, error)
+	DeadPodcastsFunc              func(ctx context.Context) ([]*podcastindex.Podcast, error)
+	GetEpisodesFunc               func(ctx context.Context, podcast podcastindex.Podcast, params *podcastindex.GetEpisodesParams) (*[]podcastindex.Episode, error)
+	GetEpisodesByFeedIDFunc       func(ctx context.Context, feedID podcast.ID, params *podcastindex.GetEpisodesParams) (*[]podcastindex.Episode, error)
+	GetEpisodesByFeedURLFunc      func(ctx context.Context, feedURL url.URL, params *podcastindex.GetEpisodesParams) (*[]podcastindex.Episode, error)
+	GetEpisodesByITunesIDFunc     func(ctx context.Context, itunesID podcast.ITunesID, params *podcastindex.GetEpisodesParams) (*[]podcastindex.Episode, error)
+	GetEpisodesByPodcastGUIDFunc  func(ctx context.Context, guid podcast.GUID, params *podcastindex.GetEpisodesParams) (*[]podcastindex.Episode, error)
+	GetEpisodeByIDFunc            func(ctx context.Context, feedID episode.ID) (*podcastindex.Episode, error)
+	GetEpisodeByGUIDFunc          func(ctx context.Context, guid episode.GUID, scope podcastindex.EpisodeGUIDScope, params *podcastindex.GetEpisodesParams) (*podcastindex.Episode, error)
+	GetLiveEpisodesFunc           func(ctx context.Context, params *podcastindex.LiveEpisodesParams) (*[]podcastindex.Episode, error)
+	RandomEpisodesFunc            func(ctx context.Context, params *podcastindex.RandomEpisodesParams) (*[]podcastindex.Episode, error)
+	AllEpisodesByFeedIDFunc       func(ctx context.Context, feedID podcast.ID, params *podcastindex.GetEpisodesParams, cursor *podcastindex.EpisodeCursor) iter.Seq2[podcastindex.Episode, error]
+	RecentEpisodesFunc            func(ctx context.Context, params *podcastindex.RecentEpisodesParams) (*[]podcastindex.Episode, error)
+	AllRecentEpisodesFunc         func(ctx context.Context, params *podcastindex.RecentEpisodesParams, cursor *podcastindex.EpisodeCursor) iter.Seq2[podcastindex.Episode, error]
+	RecentFeedsFunc               func(ctx context.Context, params *podcastindex.RecentFeedsParams) ([]*podcastindex.Podcast, error)
+	RecentNewFeedsFunc            func(ctx context.Context, params *podcastindex.RecentNewFeedsParams) ([]*podcastindex.Podcast, error)
+	RecentNewValueFeedsFunc       func(ctx context.Context, params *podcastindex.RecentNewValueFeedsParams) ([]*podcastindex.Podcast, error)
+	RecentSoundbitesFunc          func(ctx context.Context, params *podcastindex.RecentSoundbitesParams) ([]podcastindex.RecentSoundbite, error)
+	RecentDataFunc                func(ctx context.Context, params *podcastindex.RecentDataParams) (*podcastindex.RecentData, error)
+	GetValueByFeedIDFunc          func(ctx context.Context, feedID podcast.ID) (*podcastindex.PodcastValue, error)
+	GetValueByITunesIDFunc        func(ctx context.Context, itunesID podcast.ITunesID) (*podcastindex.PodcastValue, error)
+	GetValueByPodcastGUIDFunc     func(ctx context.Context, guid podcast.GUID) (*podcastindex.PodcastValue, error)
+	GetValueByEpisodeGUIDFunc     func(ctx context.Context, podcastGUID podcast.GUID, episodeGUID episode.GUID) (*podcastindex.PodcastValue, error)
+	GetValuesByEpisodeGUIDsFunc   func(ctx context.Context, guids map[podcast.GUID][]episode.GUID) ([]podcastindex.PodcastValue, error)
+	GetPodcastsByFeedIDsFunc      func(ctx context.Context, feedIDs []podcast.ID, params *podcastindex.BulkParams) []podcastindex.BulkResult[podcast.ID, *podcastindex.Podcast]
+	StreamPodcastsByFeedIDsFunc   func(ctx context.Context, feedIDs []podcast.ID, params *podcastindex.BulkParams) iter.Seq[podcastindex.BulkResult[podcast.ID, *podcastindex.Podcast]]
+	GetPodcastsByITunesIDsFunc    func(ctx context.Context, itunesIDs []podcast.ITunesID, params *podcastindex.BulkParams) []podcastindex.BulkResult[podcast.ITunesID, *podcastindex.Podcast]
+	StreamPodcastsByITunesIDsFunc func(ctx context.Context, itunesIDs []podcast.ITunesID, params *podcastindex.BulkParams) iter.Seq[podcastindex.BulkResult[podcast.ITunesID, *podcastindex.Podcast]]
+	GetEpisodesByIDsFunc          func(ctx context.Context, episodeIDs []episode.ID, params *podcastindex.BulkParams) []podcastindex.BulkResult[episode.ID, *podcastindex.Episode]
+	StreamEpisodesByIDsFunc       func(ctx context.Context, episodeIDs []episode.ID, params *podcastindex.BulkParams) iter.Seq[podcastindex.BulkResult[episode.ID, *podcastindex.Episode]]
+	AppleSearchFunc               func(ctx context.Context, term string, params *podcastindex.AppleSearchParams) ([]podcastindex.AppleResult, error)
+	AppleLookupFunc               func(ctx context.Context, itunesID podcast.ITunesID) ([]podcastindex.AppleResult, error)
+	CategoriesFunc                func(ctx context.Context) ([]podcast.Category, error)
+	CurrentStatsFunc              func(ctx context.Context) (*podcastindex.Stats, error)
+	DailyCountsFunc               func(ctx context.Context) (map[string]int64, error)
+	AddPodcastByFeedURLFunc       func(ctx context.Context, feedURL url.URL, params *podcastindex.AddPodcastByFeedURLParams) (*podcastindex.AddPodcastResult, error)
+	AddPodcastByITunesIDFunc      func(ctx context.Context, itunesID podcast.ITunesID) (*podcastindex.AddPodcastResult, error)
+	NotifyFeedChangedFunc         func(ctx context.Context, params podcastindex.NotifyFeedChangedParams) (*podcastindex.NotifyFeedChangedResult, error)
+	mu                            sync.Mutex
+	calls                         []Call
+}
+
+// Stub implements podcastindex.PodcastIndex; this fails to compile if a method of the interface is missing from Stub.
+var _ podcastindex.PodcastIndex = (*Stub)(nil)
+
+// Call is a call made to a Stub method.
+type Call struct {
+	// Method is the name of the method called, e.g. "SearchPodcastsByTerm".
+	Method string
+	// Args are the arguments of the call, after the context.
+	Args []any
+}
+
+// Calls returns the calls made to the Stub, in the order they were made.
+func (s *Stub) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.calls)
+}
+
+// CallsTo returns the calls made to a method of the Stub, e.g. "SearchPodcastsByTerm", in the order they were made.
+func (s *Stub) CallsTo(method string) []Call {
+	var calls []Call
+	for _, call := range s.Calls() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+func (s *Stub) record(method string, args ...any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, Call{Method: method, Args: args})
+}
+
+func notStubbed(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotStubbed, method)
+}
+
+// notStubbedSeq returns an iterator yielding the ErrNotStubbed error of an iterator method.
+func notStubbedSeq[T any](method string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, notStubbed(method))
+	}
+}
+
+// notStubbedResults returns a result with the ErrNotStubbed error for each ID of a bulk method.
+func notStubbedResults[ID any, T any](method string, ids []ID) []podcastindex.BulkResult[ID, T] {
+	results := make([]podcastindex.BulkResult[ID, T], len(ids))
+	for i, id := range ids {
+		results[i] = podcastindex.BulkResult[ID, T]{Index: i, ID: id, Err: notStubbed(method)}
+	}
+	return results
+}
+
+// SearchPodcastsByTerm records the call, and returns the result of SearchPodcastsByTermFunc.
+func (s *Stub) SearchPodcastsByTerm(ctx context.Context, term string, params *podcastindex.SearchPodcastsByTermParams) ([]*podcastindex.Podcast, error) {
+	s.record("SearchPodcastsByTerm", term, params)
+	if s.SearchPodcastsByTermFunc == nil {
+		return nil, notStubbed("SearchPodcastsByTerm")
+	}
+	return s.SearchPodcastsByTermFunc(ctx, term, params)
+}
+
+// SearchPodcastsByTitle records the call, and returns the result of SearchPodcastsByTitleFunc.
+func (s *Stub) SearchPodcastsByTitle(ctx context.Context, title string, params *podcastindex.SearchPodcastsByTitleParams) ([]*podcastindex.Podcast, error) {
+	s.record("SearchPodcastsByTitle", title, params)
+	if s.SearchPodcastsByTitleFunc == nil {
+		return nil, notStubbed("SearchPodcastsByTitle")
+	}
+	return s.SearchPodcastsByTitleFunc(ctx, title, params)
+}
+
+// SearchPodcastsByPerson records the call, and returns the result of SearchPodcastsByPersonFunc.
+func (s *Stub) SearchPodcastsByPerson(ctx context.Context, person string, params *podcastindex.SearchPodcastsByPersonParams) ([]*podcastindex.Podcast, error) {
+	s.record("SearchPodcastsByPerson", person, params)
+	if s.SearchPodcastsByPersonFunc == nil {
+		return nil, notStubbed("SearchPodcastsByPerson")
+	}
+	return s.SearchPodcastsByPersonFunc(ctx, person, params)
+}
+
+// SearchMusicPodcastsByTerm records the call, and returns the result of SearchMusicPodcastsByTermFunc.
+func (s *Stub) SearchMusicPodcastsByTerm(ctx context.Context, title string, params *podcastindex.SearchMusicPodcastsByTermParams) ([]*podcastindex.Podcast, error) {
+	s.record("SearchMusicPodcastsByTerm", title, params)
+	if s.SearchMusicPodcastsByTermFunc == nil {
+		return nil, notStubbed("SearchMusicPodcastsByTerm")
+	}
+	return s.SearchMusicPodcastsByTermFunc(ctx, title, params)
+}
+
+// GetPodcastByFeedID records the call, and returns the result of GetPodcastByFeedIDFunc.
+func (s *Stub) GetPodcastByFeedID(ctx context.Context, feedID podcast.ID) (*podcastindex.Podcast, error) {
+	s.record("GetPodcastByFeedID", feedID)
+	if s.GetPodcastByFeedIDFunc == nil {
+		return nil, notStubbed("GetPodcastByFeedID")
+	}
+	return s.GetPodcastByFeedIDFunc(ctx, feedID)
+}
+
+// GetPodcastByURL records the call, and returns the result of GetPodcastByURLFunc.
+func (s *Stub) GetPodcastByURL(ctx context.Context, feedURL url.URL) (*podcastindex.Podcast, error) {
+	s.record("GetPodcastByURL", feedURL)
+	if s.GetPodcastByURLFunc == nil {
+		return nil, notStubbed("GetPodcastByURL")
+	}
+	return s.GetPodcastByURLFunc(ctx, feedURL)
+}
+
+// GetPodcastByGUID records the call, and returns the result of GetPodcastByGUIDFunc.
+func (s *Stub) GetPodcastByGUID(ctx context.Context, guid podcast.GUID) (*podcastindex.Podcast, error) {
+	s.record("GetPodcastByGUID", guid)
+	if s.GetPodcastByGUIDFunc == nil {
+		return nil, notStubbed("GetPodcastByGUID")
+	}
+	return s.GetPodcastByGUIDFunc(ctx, guid)
+}
+
+// GetPodcastByITunesID records the call, and returns the result of GetPodcastByITunesIDFunc.
+func (s *Stub) GetPodcastByITunesID(ctx context.Context, itunesID podcast.ITunesID) (*podcastindex.Podcast, error) {
+	s.record("GetPodcastByITunesID", itunesID)
+	if s.GetPodcastByITunesIDFunc == nil {
+		return nil, notStubbed("GetPodcastByITunesID")
+	}
+	return s.GetPodcastByITunesIDFunc(ctx, itunesID)
+}
+
+// GetPodcastsByGUIDs records the call, and returns the result of GetPodcastsByGUIDsFunc.
+func (s *Stub) GetPodcastsByGUIDs(ctx context.Context, guids []podcast.GUID) (map[podcast.GUID]*podcastindex.Podcast, []podcast.GUID, error) {
+	s.record("GetPodcastsByGUIDs", guids)
+	if s.GetPodcastsByGUIDsFunc == nil {
+		return nil, nil, notStubbed("GetPodcastsByGUIDs")
+	}
+	return s.GetPodcastsByGUIDsFunc(ctx, guids)
+}
+
+// PodcastsByTag records the call, and returns the result of PodcastsByTagFunc.
+func (s *Stub) PodcastsByTag(ctx context.Context, tag podcast.Tag, params *podcastindex.PodcastsByTagParams) ([]*podcastindex.Podcast, int, error) {
+	s.record("PodcastsByTag", tag, params)
+	if s.PodcastsByTagFunc == nil {
+		return nil, 0, notStubbed("PodcastsByTag")
+	}
+	return s.PodcastsByTagFunc(ctx, tag, params)
+}
+
+// AllPodcastsByTag records the call, and returns the result of AllPodcastsByTagFunc.
+func (s *Stub) AllPodcastsByTag(ctx context.Context, tag podcast.Tag, params *podcastindex.PodcastsByTagParams) iter.Seq2[*podcastindex.Podcast, error] {
+	s.record("AllPodcastsByTag", tag, params)
+	if s.AllPodcastsByTagFunc == nil {
+		return notStubbedSeq[*podcastindex.Podcast]("AllPodcastsByTag")
+	}
+	return s.AllPodcastsByTagFunc(ctx, tag, params)
+}
+
+// PodcastsByMedium records the call, and returns the result of PodcastsByMediumFunc.
+func (s *Stub) PodcastsByMedium(ctx context.Context, medium podcast.Medium, params *podcastindex.PodcastsByMediumParams) ([]*podcastindex.Podcast, error) {
+	s.record("PodcastsByMedium", medium, params)
+	if s.PodcastsByMediumFunc == nil {
+		return nil, notStubbed("PodcastsByMedium")
+	}
+	return s.PodcastsByMediumFunc(ctx, medium, params)
+}
+
+// TrendingPodcasts records the call, and returns the result of TrendingPodcastsFunc.
+func (s *Stub) TrendingPodcasts(ctx context.Context, params *podcastindex.TrendingPodcastsParams) ([]*podcastindex.Podcast, error) {
+	s.record("TrendingPodcasts", params)
+	if s.TrendingPodcastsFunc == nil {
+		return nil, notStubbed("TrendingPodcasts")
+	}
+	return s.TrendingPodcastsFunc(ctx, params)
+}
+
+// DeadPodcasts records the call, and returns the result of DeadPodcastsFunc.
+func (s *Stub) DeadPodcasts(ctx context.Context) ([]*podcastindex.Podcast, error) {
+	s.record("DeadPodcasts")
+	if s.DeadPodcastsFunc == nil {
+		return nil, notStubbed("DeadPodcasts")
+	}
+	return s.DeadPodcastsFunc(ctx)
+}
+
+// GetEpisodes records the call, and returns the result of GetEpisodesFunc.
+func (s *Stub) GetEpisodes(ctx context.Context, podcast podcastindex.Podcast, params *podcastindex.GetEpisodesParams) (*[]podcastindex.Episode, error) {
+	s.record("GetEpisodes", podcast, params)
+	if s.GetEpisodesFunc == nil {
+		return nil, notStubbed("GetEpisodes")
+	}
+	return s.GetEpisodesFunc(ctx, podcast, params)
+}
+
+// GetEpisodesByFeedID records the call, and returns the result of GetEpisodesByFeedIDFunc.
+func (s *Stub) GetEpisodesByFeedID(ctx context.Context, feedID podcast.ID, params *podcastindex.GetEpisodesParams) (*[]podcastindex.Episode, error) {
+	s.record("GetEpisodesByFeedID", feedID, params)
+	if s.GetEpisodesByFeedIDFunc == nil {
+		return nil, notStubbed("GetEpisodesByFeedID")
+	}
+	return s.GetEpisodesByFeedIDFunc(ctx, feedID, params)
+}
+
+// GetEpisodesByFeedURL records the call, and returns the result of GetEpisodesByFeedURLFunc.
+func (s *Stub) GetEpisodesByFeedURL(ctx context.Context, feedURL url.URL, params *podcastindex.GetEpisodesParams) (*[]podcastindex.Episode, error) {
+	s.record("GetEpisodesByFeedURL", feedURL, params)
+	if s.GetEpisodesByFeedURLFunc == nil {
+		return nil, notStubbed("GetEpisodesByFeedURL")
+	}
+	return s.GetEpisodesByFeedURLFunc(ctx, feedURL, params)
+}
+
+// GetEpisodesByITunesID records the call, and returns the result of GetEpisodesByITunesIDFunc.
+func (s *Stub) GetEpisodesByITunesID(ctx context.Context, itunesID podcast.ITunesID, params *podcastindex.GetEpisodesParams) (*[]podcastindex.Episode, error) {
+	s.record("GetEpisodesByITunesID", itunesID, params)
+	if s.GetEpisodesByITunesIDFunc == nil {
+		return nil, notStubbed("GetEpisodesByITunesID")
+	}
+	return s.GetEpisodesByITunesIDFunc(ctx, itunesID, params)
+}
+
+// GetEpisodesByPodcastGUID records the call, and returns the result of GetEpisodesByPodcastGUIDFunc.
+func (s *Stub) GetEpisodesByPodcastGUID(ctx context.Context, guid podcast.GUID, params *podcastindex.GetEpisodesParams) (*[]podcastindex.Episode, error) {
+	s.record("GetEpisodesByPodcastGUID", guid, params)
+	if s.GetEpisodesByPodcastGUIDFunc == nil {
+		return nil, notStubbed("GetEpisodesByPodcastGUID")
+	}
+	return s.GetEpisodesByPodcastGUIDFunc(ctx, guid, params)
+}
+
+// GetEpisodeByID records the call, and returns the result of GetEpisodeByIDFunc.
+func (s *Stub) GetEpisodeByID(ctx context.Context, feedID episode.ID) (*podcastindex.Episode, error) {
+	s.record("GetEpisodeByID", feedID)
+	if s.GetEpisodeByIDFunc == nil {
+		return nil, notStubbed("GetEpisodeByID")
+	}
+	return s.GetEpisodeByIDFunc(ctx, feedID)
+}
+
+// GetEpisodeByGUID records the call, and returns the result of GetEpisodeByGUIDFunc.
+func (s *Stub) GetEpisodeByGUID(ctx context.Context, guid episode.GUID, scope podcastindex.EpisodeGUIDScope, params *podcastindex.GetEpisodesParams) (*podcastindex.Episode, error) {
+	s.record("GetEpisodeByGUID", guid, scope, params)
+	if s.GetEpisodeByGUIDFunc == nil {
+		return nil, notStubbed("GetEpisodeByGUID")
+	}
+	return s.GetEpisodeByGUIDFunc(ctx, guid, scope, params)
+}
+
+// GetLiveEpisodes records the call, and returns the result of GetLiveEpisodesFunc.
+func (s *Stub) GetLiveEpisodes(ctx context.Context, params *podcastindex.LiveEpisodesParams) (*[]podcastindex.Episode, error) {
+	s.record("GetLiveEpisodes", params)
+	if s.GetLiveEpisodesFunc == nil {
+		return nil, notStubbed("GetLiveEpisodes")
+	}
+	return s.GetLiveEpisodesFunc(ctx, params)
+}
+
+// RandomEpisodes records the call, and returns the result of RandomEpisodesFunc.
+func (s *Stub) RandomEpisodes(ctx context.Context, params *podcastindex.RandomEpisodesParams) (*[]podcastindex.Episode, error) {
+	s.record("RandomEpisodes", params)
+	if s.RandomEpisodesFunc == nil {
+		return nil, notStubbed("RandomEpisodes")
+	}
+	return s.RandomEpisodesFunc(ctx, params)
+}
+
+// AllEpisodesByFeedID records the call, and returns the result of AllEpisodesByFeedIDFunc.
+func (s *Stub) AllEpisodesByFeedID(ctx context.Context, feedID podcast.ID, params *podcastindex.GetEpisodesParams, cursor *podcastindex.EpisodeCursor) iter.Seq2[podcastindex.Episode, error] {
+	s.record("AllEpisodesByFeedID", feedID, params, cursor)
+	if s.AllEpisodesByFeedIDFunc == nil {
+		return notStubbedSeq[podcastindex.Episode]("AllEpisodesByFeedID")
+	}
+	return s.AllEpisodesByFeedIDFunc(ctx, feedID, params, cursor)
+}
+
+// RecentEpisodes records the call, and returns the result of RecentEpisodesFunc.
+func (s *Stub) RecentEpisodes(ctx context.Context, params *podcastindex.RecentEpisodesParams) (*[]podcastindex.Episode, error) {
+	s.record("RecentEpisodes", params)
+	if s.RecentEpisodesFunc == nil {
+		return nil, notStubbed("RecentEpisodes")
+	}
+	return s.RecentEpisodesFunc(ctx, params)
+}
+
+// AllRecentEpisodes records the call, and returns the result of AllRecentEpisodesFunc.
+func (s *Stub) AllRecentEpisodes(ctx context.Context, params *podcastindex.RecentEpisodesParams, cursor *podcastindex.EpisodeCursor) iter.Seq2[podcastindex.Episode, error] {
+	s.record("AllRecentEpisodes", params, cursor)
+	if s.AllRecentEpisodesFunc == nil {
+		return notStubbedSeq[podcastindex.Episode]("AllRecentEpisodes")
+	}
+	return s.AllRecentEpisodesFunc(ctx, params, cursor)
+}
+
+// RecentFeeds records the call, and returns the result of RecentFeedsFunc.
+func (s *Stub) RecentFeeds(ctx context.Context, params *podcastindex.RecentFeedsParams) ([]*podcastindex.Podcast, error) {
+	s.record("RecentFeeds", params)
+	if s.RecentFeedsFunc == nil {
+		return nil, notStubbed("RecentFeeds")
+	}
+	return s.RecentFeedsFunc(ctx, params)
+}
+
+// RecentNewFeeds records the call, and returns the result of RecentNewFeedsFunc.
+func (s *Stub) RecentNewFeeds(ctx context.Context, params *podcastindex.RecentNewFeedsParams) ([]*podcastindex.Podcast, error) {
+	s.record("RecentNewFeeds", params)
+	if s.RecentNewFeedsFunc == nil {
+		return nil, notStubbed("RecentNewFeeds")
+	}
+	return s.RecentNewFeedsFunc(ctx, params)
+}
+
+// RecentNewValueFeeds records the call, and returns the result of RecentNewValueFeedsFunc.
+func (s *Stub) RecentNewValueFeeds(ctx context.Context, params *podcastindex.RecentNewValueFeedsParams) ([]*podcastindex.Podcast, error) {
+	s.record("RecentNewValueFeeds", params)
+	if s.RecentNewValueFeedsFunc == nil {
+		return nil, notStubbed("RecentNewValueFeeds")
+	}
+	return s.RecentNewValueFeedsFunc(ctx, params)
+}
+
+// RecentSoundbites records the call, and returns the result of RecentSoundbitesFunc.
+func (s *Stub) RecentSoundbites(ctx context.Context, params *podcastindex.RecentSoundbitesParams) ([]podcastindex.RecentSoundbite, error) {
+	s.record("RecentSoundbites", params)
+	if s.RecentSoundbitesFunc == nil {
+		return nil, notStubbed("RecentSoundbites")
+	}
+	return s.RecentSoundbitesFunc(ctx, params)
+}
+
+// RecentData records the call, and returns the result of RecentDataFunc.
+func (s *Stub) RecentData(ctx context.Context, params *podcastindex.RecentDataParams) (*podcastindex.RecentData, error) {
+	s.record("RecentData", params)
+	if s.RecentDataFunc == nil {
+		return nil, notStubbed("RecentData")
+	}
+	return s.RecentDataFunc(ctx, params)
+}
+
+// GetValueByFeedID records the call, and returns the result of GetValueByFeedIDFunc.
+func (s *Stub) GetValueByFeedID(ctx context.Context, feedID podcast.ID) (*podcastindex.PodcastValue, error) {
+	s.record("GetValueByFeedID", feedID)
+	if s.GetValueByFeedIDFunc == nil {
+		return nil, notStubbed("GetValueByFeedID")
+	}
+	return s.GetValueByFeedIDFunc(ctx, feedID)
+}
+
+// GetValueByITunesID records the call, and returns the result of GetValueByITunesIDFunc.
+func (s *Stub) GetValueByITunesID(ctx context.Context, itunesID podcast.ITunesID) (*podcastindex.PodcastValue, error) {
+	s.record("GetValueByITunesID", itunesID)
+	if s.GetValueByITunesIDFunc == nil {
+		return nil, notStubbed("GetValueByITunesID")
+	}
+	return s.GetValueByITunesIDFunc(ctx, itunesID)
+}
+
+// GetValueByPodcastGUID records the call, and returns the result of GetValueByPodcastGUIDFunc.
+func (s *Stub) GetValueByPodcastGUID(ctx context.Context, guid podcast.GUID) (*podcastindex.PodcastValue, error) {
+	s.record("GetValueByPodcastGUID", guid)
+	if s.GetValueByPodcastGUIDFunc == nil {
+		return nil, notStubbed("GetValueByPodcastGUID")
+	}
+	return s.GetValueByPodcastGUIDFunc(ctx, guid)
+}
+
+// GetValueByEpisodeGUID records the call, and returns the result of GetValueByEpisodeGUIDFunc.
+func (s *Stub) GetValueByEpisodeGUID(ctx context.Context, podcastGUID podcast.GUID, episodeGUID episode.GUID) (*podcastindex.PodcastValue, error) {
+	s.record("GetValueByEpisodeGUID", podcastGUID, episodeGUID)
+	if s.GetValueByEpisodeGUIDFunc == nil {
+		return nil, notStubbed("GetValueByEpisodeGUID")
+	}
+	return s.GetValueByEpisodeGUIDFunc(ctx, podcastGUID, episodeGUID)
+}
+
+// GetValuesByEpisodeGUIDs records the call, and returns the result of GetValuesByEpisodeGUIDsFunc.
+func (s *Stub) GetValuesByEpisodeGUIDs(ctx context.Context, guids map[podcast.GUID][]episode.GUID) ([]podcastindex.PodcastValue, error) {
+	s.record("GetValuesByEpisodeGUIDs", guids)
+	if s.GetValuesByEpisodeGUIDsFunc == nil {
+		return nil, notStubbed("GetValuesByEpisodeGUIDs")
+	}
+	return s.GetValuesByEpisodeGUIDsFunc(ctx, guids)
+}
+
+// GetPodcastsByFeedIDs records the call, and returns the result of GetPodcastsByFeedIDsFunc.
+func (s *Stub) GetPodcastsByFeedIDs(ctx context.Context, feedIDs []podcast.ID, params *podcastindex.BulkParams) []podcastindex.BulkResult[podcast.ID, *podcastindex.Podcast] {
+	s.record("GetPodcastsByFeedIDs", feedIDs, params)
+	if s.GetPodcastsByFeedIDsFunc == nil {
+		return notStubbedResults[podcast.ID, *podcastindex.Podcast]("GetPodcastsByFeedIDs", feedIDs)
+	}
+	return s.GetPodcastsByFeedIDsFunc(ctx, feedIDs, params)
+}
+
+// StreamPodcastsByFeedIDs records the call, and returns the result of StreamPodcastsByFeedIDsFunc.
+func (s *Stub) StreamPodcastsByFeedIDs(ctx context.Context, feedIDs []podcast.ID, params *podcastindex.BulkParams) iter.Seq[podcastindex.BulkResult[podcast.ID, *podcastindex.Podcast]] {
+	s.record("StreamPodcastsByFeedIDs", feedIDs, params)
+	if s.StreamPodcastsByFeedIDsFunc == nil {
+		return slices.Values(notStubbedResults[podcast.ID, *podcastindex.Podcast]("StreamPodcastsByFeedIDs", feedIDs))
+	}
+	return s.StreamPodcastsByFeedIDsFunc(ctx, feedIDs, params)
+}
+
+// GetPodcastsByITunesIDs records the call, and returns the result of GetPodcastsByITunesIDsFunc.
+func (s *Stub) GetPodcastsByITunesIDs(ctx context.Context, itunesIDs []podcast.ITunesID, params *podcastindex.BulkParams) []podcastindex.BulkResult[podcast.ITunesID, *podcastindex.Podcast] {
+	s.record("GetPodcastsByITunesIDs", itunesIDs, params)
+	if s.GetPodcastsByITunesIDsFunc == nil {
+		return notStubbedResults[podcast.ITunesID, *podcastindex.Podcast]("GetPodcastsByITunesIDs", itunesIDs)
+	}
+	return s.GetPodcastsByITunesIDsFunc(ctx, itunesIDs, params)
+}
+
+// StreamPodcastsByITunesIDs records the call, and returns the result of StreamPodcastsByITunesIDsFunc.
+func (s *Stub) StreamPodcastsByITunesIDs(ctx context.Context, itunesIDs []podcast.ITunesID, params *podcastindex.BulkParams) iter.Seq[podcastindex.BulkResult[podcast.ITunesID, *podcastindex.Podcast]] {
+	s.record("StreamPodcastsByITunesIDs", itunesIDs, params)
+	if s.StreamPodcastsByITunesIDsFunc == nil {
+		return slices.Values(notStubbedResults[podcast.ITunesID, *podcastindex.Podcast]("StreamPodcastsByITunesIDs", itunesIDs))
+	}
+	return s.StreamPodcastsByITunesIDsFunc(ctx, itunesIDs, params)
+}
+
+// GetEpisodesByIDs records the call, and returns the result of GetEpisodesByIDsFunc.
+func (s *Stub) GetEpisodesByIDs(ctx context.Context, episodeIDs []episode.ID, params *podcastindex.BulkParams) []podcastindex.BulkResult[episode.ID, *podcastindex.Episode] {
+	s.record("GetEpisodesByIDs", episodeIDs, params)
+	if s.GetEpisodesByIDsFunc == nil {
+		return notStubbedResults[episode.ID, *podcastindex.Episode]("GetEpisodesByIDs", episodeIDs)
+	}
+	return s.GetEpisodesByIDsFunc(ctx, episodeIDs, params)
+}
+
+// StreamEpisodesByIDs records the call, and returns the result of StreamEpisodesByIDsFunc.
+func (s *Stub) StreamEpisodesByIDs(ctx context.Context, episodeIDs []episode.ID, params *podcastindex.BulkParams) iter.Seq[podcastindex.BulkResult[episode.ID, *podcastindex.Episode]] {
+	s.record("StreamEpisodesByIDs", episodeIDs, params)
+	if s.StreamEpisodesByIDsFunc == nil {
+		return slices.Values(notStubbedResults[episode.ID, *podcastindex.Episode]("StreamEpisodesByIDs", episodeIDs))
+	}
+	return s.StreamEpisodesByIDsFunc(ctx, episodeIDs, params)
+}
+
+// AppleSearch records the call, and returns the result of AppleSearchFunc.
+func (s *Stub) AppleSearch(ctx context.Context, term string, params *podcastindex.AppleSearchParams) ([]podcastindex.AppleResult, error) {
+	s.record("AppleSearch", term, params)
+	if s.AppleSearchFunc == nil {
+		return nil, notStubbed("AppleSearch")
+	}
+	return s.AppleSearchFunc(ctx, term, params)
+}
+
+// AppleLookup records the call, and returns the result of AppleLookupFunc.
+func (s *Stub) AppleLookup(ctx context.Context, itunesID podcast.ITunesID) ([]podcastindex.AppleResult, error) {
+	s.record("AppleLookup", itunesID)
+	if s.AppleLookupFunc == nil {
+		return nil, notStubbed("AppleLookup")
+	}
+	return s.AppleLookupFunc(ctx, itunesID)
+}
+
+// Categories records the call, and returns the result of CategoriesFunc.
+func (s *Stub) Categories(ctx context.Context) ([]podcast.Category, error) {
+	s.record("Categories")
+	if s.CategoriesFunc == nil {
+		return nil, notStubbed("Categories")
+	}
+	return s.CategoriesFunc(ctx)
+}
+
+// CurrentStats records the call, and returns the result of CurrentStatsFunc.
+func (s *Stub) CurrentStats(ctx context.Context) (*podcastindex.Stats, error) {
+	s.record("CurrentStats")
+	if s.CurrentStatsFunc == nil {
+		return nil, notStubbed("CurrentStats")
+	}
+	return s.CurrentStatsFunc(ctx)
+}
+
+// DailyCounts records the call, and returns the result of DailyCountsFunc.
+func (s *Stub) DailyCounts(ctx context.Context) (map[string]int64, error) {
+	s.record("DailyCounts")
+	if s.DailyCountsFunc == nil {
+		return nil, notStubbed("DailyCounts")
+	}
+	return s.DailyCountsFunc(ctx)
+}
+
+// AddPodcastByFeedURL records the call, and returns the result of AddPodcastByFeedURLFunc.
+func (s *Stub) AddPodcastByFeedURL(ctx context.Context, feedURL url.URL, params *podcastindex.AddPodcastByFeedURLParams) (*podcastindex.AddPodcastResult, error) {
+	s.record("AddPodcastByFeedURL", feedURL, params)
+	if s.AddPodcastByFeedURLFunc == nil {
+		return nil, notStubbed("AddPodcastByFeedURL")
+	}
+	return s.AddPodcastByFeedURLFunc(ctx, feedURL, params)
+}
+
+// AddPodcastByITunesID records the call, and returns the result of AddPodcastByITunesIDFunc.
+func (s *Stub) AddPodcastByITunesID(ctx context.Context, itunesID podcast.ITunesID) (*podcastindex.AddPodcastResult, error) {
+	s.record("AddPodcastByITunesID", itunesID)
+	if s.AddPodcastByITunesIDFunc == nil {
+		return nil, notStubbed("AddPodcastByITunesID")
+	}
+	return s.AddPodcastByITunesIDFunc(ctx, itunesID)
+}
+
+// NotifyFeedChanged records the call, and returns the result of NotifyFeedChangedFunc.
+func (s *Stub) NotifyFeedChanged(ctx context.Context, params podcastindex.NotifyFeedChangedParams) (*podcastindex.NotifyFeedChangedResult, error) {
+	s.record("NotifyFeedChanged", params)
+	if s.NotifyFeedChangedFunc == nil {
+		return nil, notStubbed("NotifyFeedChanged")
+	}
+	return s.NotifyFeedChangedFunc(ctx, params)
+}
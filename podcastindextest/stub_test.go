@@ -0,0 +1,77 @@
+package podcastindextest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/jjgmckenzie/podcastindex"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+func TestStubHasFuncForEveryMethod(t *testing.T) {
+	podcastIndex := reflect.TypeFor[podcastindex.PodcastIndex]()
+	stub := reflect.TypeFor[Stub]()
+	for i := range podcastIndex.NumMethod() {
+		method := podcastIndex.Method(i)
+		field, ok := stub.FieldByName(method.Name + "Func")
+		if !ok {
+			t.Errorf("Stub is missing %sFunc", method.Name)
+			continue
+		}
+		// the method of an interface type has no receiver, like the function field.
+		if field.Type != method.Type {
+			t.Errorf("expected %sFunc to be a %v, got %v", method.Name, method.Type, field.Type)
+		}
+	}
+}
+
+func TestStub(t *testing.T) {
+	ctx := context.Background()
+	stub := &Stub{
+		SearchPodcastsByTermFunc: func(ctx context.Context, term string, params *podcastindex.SearchPodcastsByTermParams) ([]*podcastindex.Podcast, error) {
+			return []*podcastindex.Podcast{{Title: term}}, nil
+		},
+	}
+	var client podcastindex.PodcastIndex = stub
+
+	t.Run("a stubbed method returns the result of its function", func(t *testing.T) {
+		podcasts, err := client.SearchPodcastsByTerm(ctx, "batman", &podcastindex.SearchPodcastsByTermParams{Max: 5})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(podcasts) != 1 || podcasts[0].Title != "batman" {
+			t.Errorf("unexpected podcasts %v", podcasts)
+		}
+	})
+	t.Run("a method which is not stubbed returns ErrNotStubbed", func(t *testing.T) {
+		if _, err := client.GetPodcastByFeedID(ctx, 920666); !errors.Is(err, ErrNotStubbed) {
+			t.Errorf("expected ErrNotStubbed, got %v", err)
+		}
+		for _, err := range client.AllEpisodesByFeedID(ctx, 920666, nil, nil) {
+			if !errors.Is(err, ErrNotStubbed) {
+				t.Errorf("expected ErrNotStubbed, got %v", err)
+			}
+		}
+		results := client.GetPodcastsByFeedIDs(ctx, []podcast.ID{1, 2}, nil)
+		if len(results) != 2 || results[1].ID != 2 || !errors.Is(results[1].Err, ErrNotStubbed) {
+			t.Errorf("expected a result with ErrNotStubbed for each ID, got %+v", results)
+		}
+	})
+	t.Run("calls are recorded", func(t *testing.T) {
+		methods := []string{}
+		for _, call := range stub.Calls() {
+			methods = append(methods, call.Method)
+		}
+		expected := []string{"SearchPodcastsByTerm", "GetPodcastByFeedID", "AllEpisodesByFeedID", "GetPodcastsByFeedIDs"}
+		if !slices.Equal(methods, expected) {
+			t.Errorf("expected calls to %v, got %v", expected, methods)
+		}
+		calls := stub.CallsTo("SearchPodcastsByTerm")
+		if len(calls) != 1 || calls[0].Args[0] != "batman" || calls[0].Args[1].(*podcastindex.SearchPodcastsByTermParams).Max != 5 {
+			t.Errorf("expected the arguments to be recorded, got %+v", calls)
+		}
+	})
+}
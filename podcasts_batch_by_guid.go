@@ -0,0 +1,41 @@
+package podcastindex
+
+import (
+	"context"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// podcastsBatchResponse is the response from the podcasts/batch/byguid endpoint on a 200/OK response.
+type podcastsBatchResponse struct {
+	// Status indicates API request status; either "true" or "false"
+	Status string `json:"status"`
+	// AllFound indicates if every requested GUID was found in the index
+	AllFound bool `json:"allFound"`
+	// Feeds is the list of feeds found for the requested GUIDs
+	Feeds []*Podcast `json:"feeds"`
+	//  Description is the description of the response
+	Description string `json:"description"`
+}
+
+// GetPodcastsByGUIDs looks up many podcasts by their podcast.GUID in a single request.
+//
+// Returns: the podcasts found, keyed by GUID, and the requested GUIDs which were not found in the index, in request order.
+func (c *Client) GetPodcastsByGUIDs(ctx context.Context, guids []podcast.GUID) (map[podcast.GUID]*Podcast, []podcast.GUID, error) {
+	var response podcastsBatchResponse
+	err := c.api.Post(ctx, "/podcasts/batch/byguid", nil, guids, &response)
+	if err != nil {
+		return nil, nil, err
+	}
+	found := make(map[podcast.GUID]*Podcast, len(response.Feeds))
+	for _, feed := range response.Feeds {
+		found[feed.GUID] = feed
+	}
+	var notFound []podcast.GUID
+	for _, guid := range guids {
+		if _, ok := found[guid]; !ok {
+			notFound = append(notFound, guid)
+		}
+	}
+	return found, notFound, nil
+}
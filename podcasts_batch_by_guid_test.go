@@ -0,0 +1,71 @@
+package podcastindex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+func TestGetPodcastsByGUIDsRequest(t *testing.T) {
+	t.Run("the client posts the GUIDs and reports which were found", func(t *testing.T) {
+		var requestedMethod, requestedPath string
+		var requestedGUIDs []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestedMethod = r.Method
+			requestedPath = r.URL.Path
+			_ = json.NewDecoder(r.Body).Decode(&requestedGUIDs)
+			_, _ = w.Write([]byte(`{
+				"status": "true",
+				"allFound": false,
+				"feeds": [{"id": 920666, "podcastGuid": "917393e3-1b1e-5cef-ace4-edaa54e1f810", "title": "Podcasting 2.0"}],
+				"description": "Found matching feeds"
+			}`))
+		}))
+		defer server.Close()
+
+		serverURL, _ := url.Parse(server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		missingGUID := podcast.GUID("00000000-0000-0000-0000-000000000000")
+		found, notFound, err := client.GetPodcastsByGUIDs(context.Background(), []podcast.GUID{testValuePodcastGUID, missingGUID})
+		if err != nil {
+			t.Fatalf("GetPodcastsByGUIDs failed: %v", err)
+		}
+		if requestedMethod != http.MethodPost || requestedPath != "/podcasts/batch/byguid" {
+			t.Errorf("expected POST /podcasts/batch/byguid, got %s %s", requestedMethod, requestedPath)
+		}
+		if len(requestedGUIDs) != 2 {
+			t.Errorf("expected 2 GUIDs to be posted, got %v", requestedGUIDs)
+		}
+		if p, ok := found[testValuePodcastGUID]; !ok || p.ID != 920666 {
+			t.Errorf("expected %s to be found, got %+v", testValuePodcastGUID, found)
+		}
+		if len(notFound) != 1 || notFound[0] != missingGUID {
+			t.Errorf("expected %s to not be found, got %v", missingGUID, notFound)
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, _, err := GetErrorServer(t).GetPodcastsByGUIDs(context.Background(), []podcast.GUID{testValuePodcastGUID})
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestGetPodcastsByGUIDsIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	found, _, err := client.GetPodcastsByGUIDs(context.Background(), []podcast.GUID{testValuePodcastGUID})
+	if err != nil {
+		t.Fatalf("GetPodcastsByGUIDs failed: %v", err)
+	}
+	if _, ok := found[testValuePodcastGUID]; !ok {
+		t.Fatalf("expected %s to be found", testValuePodcastGUID)
+	}
+}
@@ -0,0 +1,35 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// PodcastsByMediumParams is a struct that contains the optional parameters for the PodcastsByMedium method.
+//
+// The optional parameters are:
+type PodcastsByMediumParams struct {
+	// Max is the maximum number of podcasts to return; default 0 (all), maximum 1000
+	Max int
+}
+
+// PodcastsByMedium returns the feeds whose podcast:medium tag is the given podcast.Medium.
+//
+// Also accepts optional parameters to filter the results, see PodcastsByMediumParams for more details.
+func (c *Client) PodcastsByMedium(ctx context.Context, medium podcast.Medium, params *PodcastsByMediumParams) ([]*Podcast, error) {
+	var response searchResponse
+	urlParams := url.Values{"medium": {string(medium)}}
+	if params != nil {
+		if params.Max != 0 {
+			urlParams.Set("max", strconv.Itoa(params.Max))
+		}
+	}
+	err := c.api.Get(ctx, "/podcasts/bymedium", urlParams, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Feeds, nil
+}
@@ -0,0 +1,58 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+func TestPodcastsByMediumRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		expectedQuery := url.Values{
+			"medium": {"music"},
+			"max":    {"5"},
+		}
+
+		_, _ = client.PodcastsByMedium(context.Background(), podcast.MediumMusic, &PodcastsByMediumParams{Max: 5})
+		if err := searchServer.ExpectPathAndQuery("/podcasts/bymedium", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client decodes the medium", func(t *testing.T) {
+		client := GetJSONServer(t, `{"status": "true", "feeds": [{"id": 7265358, "medium": "music"}], "count": 1}`)
+		podcasts, err := client.PodcastsByMedium(context.Background(), podcast.MediumMusic, nil)
+		if err != nil {
+			t.Fatalf("PodcastsByMedium failed: %v", err)
+		}
+		if len(podcasts) != 1 || podcasts[0].Medium != string(podcast.MediumMusic) {
+			t.Errorf("unexpected podcasts: %+v", podcasts)
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).PodcastsByMedium(context.Background(), podcast.MediumMusic, nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestPodcastsByMediumIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	podcasts, err := client.PodcastsByMedium(context.Background(), podcast.MediumMusic, &PodcastsByMediumParams{Max: 5})
+	if err != nil {
+		t.Fatalf("PodcastsByMedium failed: %v", err)
+	}
+	if len(podcasts) == 0 {
+		t.Fatalf("No podcasts found")
+	}
+}
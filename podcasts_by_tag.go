@@ -0,0 +1,87 @@
+package podcastindex
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// PodcastsByTagParams is a struct that contains the optional parameters for the PodcastsByTag method.
+//
+// The optional parameters are:
+type PodcastsByTagParams struct {
+	// Max is the maximum number of podcasts to return per page; default 500, maximum 5000
+	Max int
+	// StartAt : If present, the cursor to start the page at, as returned by a previous call to PodcastsByTag.
+	StartAt int
+}
+
+// podcastsByTagResponse is the response from the podcasts/bytag endpoint on a 200/OK response.
+type podcastsByTagResponse struct {
+	// Status indicates API request status; either "true" or "false"
+	Status string `json:"status"`
+	// Feeds is the list of feeds with the tag - this is the response.
+	Feeds []*Podcast `json:"feeds"`
+	Count int        `json:"count"`
+	// NextStartAt is the cursor for the next page of feeds, or 0 if this is the last page.
+	NextStartAt int `json:"nextStartAt"`
+	//  Description is the description of the response
+	Description string `json:"description"`
+}
+
+// PodcastsByTag returns a page of the feeds which support the given podcast namespace tag.
+//
+// Returns: the page of podcasts, and the cursor to pass as PodcastsByTagParams.StartAt for the next page,
+// or 0 if there are no more pages. Use AllPodcastsByTag to iterate over every page.
+func (c *Client) PodcastsByTag(ctx context.Context, tag podcast.Tag, params *PodcastsByTagParams) ([]*Podcast, int, error) {
+	var response podcastsByTagResponse
+	urlParams := url.Values{string(tag): {""}}
+	if params != nil {
+		if params.Max != 0 {
+			urlParams.Set("max", strconv.Itoa(params.Max))
+		}
+		if params.StartAt != 0 {
+			urlParams.Set("start_at", strconv.Itoa(params.StartAt))
+		}
+	}
+	err := c.api.Get(ctx, "/podcasts/bytag", urlParams, &response)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(response.Feeds) == 0 {
+		return response.Feeds, 0, nil
+	}
+	return response.Feeds, response.NextStartAt, nil
+}
+
+// AllPodcastsByTag iterates over every feed which supports the given podcast namespace tag,
+// fetching pages from the API as the iteration proceeds.
+//
+// Iteration stops after the first error, which is yielded with a nil podcast.
+func (c *Client) AllPodcastsByTag(ctx context.Context, tag podcast.Tag, params *PodcastsByTagParams) iter.Seq2[*Podcast, error] {
+	return func(yield func(*Podcast, error) bool) {
+		var pageParams PodcastsByTagParams
+		if params != nil {
+			pageParams = *params
+		}
+		for {
+			podcasts, next, err := c.PodcastsByTag(ctx, tag, &pageParams)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, p := range podcasts {
+				if !yield(p, nil) {
+					return
+				}
+			}
+			if next == 0 || next == pageParams.StartAt {
+				return
+			}
+			pageParams.StartAt = next
+		}
+	}
+}
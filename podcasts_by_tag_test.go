@@ -0,0 +1,114 @@
+package podcastindex
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+func TestPodcastsByTagRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		expectedQuery := url.Values{
+			"podcast-valueTimeSplit": {},
+			"max":                    {"5"},
+			"start_at":               {"1000"},
+		}
+
+		_, _, _ = client.PodcastsByTag(context.Background(), podcast.TagValueTimeSplit, &PodcastsByTagParams{Max: 5, StartAt: 1000})
+		if err := searchServer.ExpectPathAndQuery("/podcasts/bytag", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, _, err := GetErrorServer(t).PodcastsByTag(context.Background(), podcast.TagValue, nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestAllPodcastsByTag(t *testing.T) {
+	// pages maps each start_at cursor to the page of feeds served for it
+	pages := map[string]string{
+		"":  `{"status": "true", "feeds": [{"id": 1}, {"id": 2}], "count": 2, "nextStartAt": 3}`,
+		"3": `{"status": "true", "feeds": [{"id": 3}], "count": 1, "nextStartAt": 0}`,
+	}
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page, ok := pages[r.URL.Query().Get("start_at")]
+		if !ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = fmt.Fprint(w, page)
+	}))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	client := NewClient(NewClientOptions{
+		BaseURL: serverURL,
+	})
+
+	t.Run("iterates over every page", func(t *testing.T) {
+		requests = 0
+		var ids []podcast.ID
+		for p, err := range client.AllPodcastsByTag(context.Background(), podcast.TagValue, nil) {
+			if err != nil {
+				t.Fatalf("AllPodcastsByTag failed: %v", err)
+			}
+			ids = append(ids, p.ID)
+		}
+		if len(ids) != 3 || ids[0] != 1 || ids[2] != 3 {
+			t.Errorf("expected podcasts 1, 2 and 3, got %v", ids)
+		}
+		if requests != 2 {
+			t.Errorf("expected 2 requests, got %d", requests)
+		}
+	})
+	t.Run("stops fetching pages when the caller stops iterating", func(t *testing.T) {
+		requests = 0
+		for range client.AllPodcastsByTag(context.Background(), podcast.TagValue, nil) {
+			break
+		}
+		if requests != 1 {
+			t.Errorf("expected 1 request, got %d", requests)
+		}
+	})
+	t.Run("yields the error and stops", func(t *testing.T) {
+		var errs int
+		for p, err := range client.AllPodcastsByTag(context.Background(), podcast.TagValue, &PodcastsByTagParams{StartAt: 99}) {
+			if err == nil || p != nil {
+				t.Fatalf("expected only an error, got %v, %v", p, err)
+			}
+			errs++
+		}
+		if errs != 1 {
+			t.Errorf("expected 1 error, got %d", errs)
+		}
+	})
+}
+
+func TestPodcastsByTagIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	podcasts, _, err := client.PodcastsByTag(context.Background(), podcast.TagValue, &PodcastsByTagParams{Max: 5})
+	if err != nil {
+		t.Fatalf("PodcastsByTag failed: %v", err)
+	}
+	if len(podcasts) == 0 {
+		t.Fatalf("No podcasts found")
+	}
+}
@@ -0,0 +1,17 @@
+package podcastindex
+
+import (
+	"context"
+)
+
+// DeadPodcasts returns every feed which has been marked dead by the index.
+//
+// Only the ID, Title and URL of each Podcast are reported by the API for this endpoint.
+func (c *Client) DeadPodcasts(ctx context.Context) ([]*Podcast, error) {
+	var response searchResponse
+	err := c.api.Get(ctx, "/podcasts/dead", nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Feeds, nil
+}
@@ -0,0 +1,30 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+)
+
+func TestDeadPodcastsRequest(t *testing.T) {
+	t.Run("the client sends the correct request path", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		_, _ = client.DeadPodcasts(context.Background())
+		if err := searchServer.ExpectPathAndQuery("/podcasts/dead", url.Values{}); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).DeadPodcasts(context.Background())
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package podcastindex
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// ErrWritePermission is returned by the Add and Hub endpoints when the API rejects the request with 403 Forbidden,
+// because the API key does not have write or publisher permissions. Check for it with errors.Is.
+//
+// Keys with these permissions can be requested from https://api.podcastindex.org
+var ErrWritePermission = errors.New("the API key lacks write or publisher permission")
+
+// AddPodcastResult is the result of adding a podcast to the index.
+type AddPodcastResult struct {
+	// FeedID is the internal PodcastIndex.org Feed ID of the podcast.
+	FeedID podcast.ID
+	// Existed indicates the podcast was already in the index, in which case FeedID is its existing ID.
+	Existed bool
+	// Description is the description of the response
+	Description string
+}
+
+// addPodcastResponse is the response from the add/* endpoints on a 200/OK response.
+type addPodcastResponse struct {
+	// Status indicates API request status; either "true" or "false"
+	Status  string `json:"status"`
+	FeedID  int    `json:"feedId"`
+	Existed bool   `json:"existed"`
+	//  Description is the description of the response
+	Description string `json:"description"`
+}
+
+// result converts the response into an AddPodcastResult.
+func (r addPodcastResponse) result() *AddPodcastResult {
+	return &AddPodcastResult{
+		FeedID:      podcast.ID(r.FeedID),
+		Existed:     r.Existed,
+		Description: r.Description,
+	}
+}
+
+// publisherError distinguishes errors caused by a key without write or publisher permission from other errors.
+//
+// The API responds 403 Forbidden to a valid key without permission for the endpoint, which is ErrWritePermission;
+// 401 Unauthorized means the credentials themselves were rejected, and stays ErrUnauthorized only.
+func publisherError(err error) error {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden {
+		return fmt.Errorf("%w: %w", ErrWritePermission, err)
+	}
+	return err
+}
@@ -0,0 +1,37 @@
+package podcastindex
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+
+	"golang.org/x/text/language"
+)
+
+// joinCategories encodes a list of category filters as the comma separated list of category IDs expected by the API.
+func joinCategories(categories []podcast.CategoryFilter) string {
+	ids := make([]string, 0, len(categories))
+	for _, category := range categories {
+		ids = append(ids, strconv.Itoa(int(category.FilterID())))
+	}
+	return strings.Join(ids, ",")
+}
+
+// joinLanguages encodes a list of language tags as the comma separated list expected by the API.
+//
+// Tags are lower-cased, as the API reports (and matches) languages in lower case;
+// see https://github.com/Podcastindex-org/docs-api/issues/142
+func joinLanguages(tags []language.Tag) string {
+	languages := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		languages = append(languages, strings.ToLower(tag.String()))
+	}
+	return strings.Join(languages, ",")
+}
+
+// unixTime encodes a time.Time as the unix timestamp expected by the API.
+func unixTime(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
@@ -0,0 +1,78 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+
+	"golang.org/x/text/language"
+)
+
+// RandomEpisodesParams is a struct that contains the optional parameters for the RandomEpisodes method.
+//
+// The optional parameters are:
+type RandomEpisodesParams struct {
+	// Max is the maximum number of episodes to return; default 1, maximum 1000
+	Max int
+	// Since : If present, only episodes published since this time will be returned.
+	Since time.Time
+	// Languages : If present, only episodes of podcasts in one of these languages will be returned.
+	Languages []language.Tag
+	// Categories : If present, only episodes of podcasts in one of these categories will be returned.
+	//
+	// Accepts podcast.Category or podcast.CategoryID values; all categories are returned by Client.Categories.
+	Categories []podcast.CategoryFilter
+	// ExcludeCategories : If present, episodes of podcasts in any of these categories will not be returned.
+	ExcludeCategories []podcast.CategoryFilter
+	// FullText : If present, return the full text value of any text fields (ex: description). If not provided, field value is truncated to 100 words.
+	FullText bool
+}
+
+// randomEpisodesResponse is the response from the episodes/random endpoint on a 200/OK response.
+//
+// Unlike the other episode endpoints, episodes are reported under "episodes" rather than "items".
+type randomEpisodesResponse struct {
+	// Status indicates API request status; either "true" or "false"
+	Status string `json:"status"`
+	// Episodes is the list of random episodes - this is the response.
+	Episodes []Episode `json:"episodes"`
+	Count    int       `json:"count"`
+	//  Description is the description of the response
+	Description string `json:"description"`
+}
+
+// RandomEpisodes returns a random batch of episodes, in no specific order.
+//
+// Also accepts optional parameters to filter the results, see RandomEpisodesParams for more details.
+func (c *Client) RandomEpisodes(ctx context.Context, params *RandomEpisodesParams) (*[]Episode, error) {
+	var response randomEpisodesResponse
+	urlParams := url.Values{}
+	if params != nil {
+		if params.Max != 0 {
+			urlParams.Set("max", strconv.Itoa(params.Max))
+		}
+		if !params.Since.IsZero() {
+			urlParams.Add("since", unixTime(params.Since))
+		}
+		if len(params.Languages) != 0 {
+			urlParams.Add("lang", joinLanguages(params.Languages))
+		}
+		if len(params.Categories) != 0 {
+			urlParams.Add("cat", joinCategories(params.Categories))
+		}
+		if len(params.ExcludeCategories) != 0 {
+			urlParams.Add("notcat", joinCategories(params.ExcludeCategories))
+		}
+		if params.FullText {
+			urlParams.Add("fulltext", "")
+		}
+	}
+	err := c.api.Get(ctx, "/episodes/random", urlParams, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Episodes, nil
+}
@@ -0,0 +1,76 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+
+	"golang.org/x/text/language"
+)
+
+// TestRandomEpisodesRequest verifies that the client sends the correct
+// request path and query parameters for the RandomEpisodes method.
+func TestRandomEpisodesRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		params := RandomEpisodesParams{
+			Max:               5,
+			Since:             time.Unix(1613805000, 0),
+			Languages:         []language.Tag{language.English},
+			Categories:        []podcast.CategoryFilter{podcast.CategoryID(102)},
+			ExcludeCategories: []podcast.CategoryFilter{podcast.Category{ID: 86, Name: "News"}},
+			FullText:          true,
+		}
+
+		expectedQuery := url.Values{
+			"max":      {"5"},
+			"since":    {"1613805000"},
+			"lang":     {"en"},
+			"cat":      {"102"},
+			"notcat":   {"86"},
+			"fulltext": {},
+		}
+
+		_, _ = client.RandomEpisodes(context.Background(), &params)
+		if err := searchServer.ExpectPathAndQuery("/episodes/random", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client decodes the episodes", func(t *testing.T) {
+		client := GetJSONServer(t, `{"status": "true", "episodes": [{"id": 42, "title": "Episode", "feedId": 75075}], "count": 1}`)
+		episodes, err := client.RandomEpisodes(context.Background(), nil)
+		if err != nil {
+			t.Fatalf("RandomEpisodes failed: %v", err)
+		}
+		if len(*episodes) != 1 || (*episodes)[0].ID != 42 {
+			t.Errorf("unexpected episodes: %+v", *episodes)
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).RandomEpisodes(context.Background(), nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestRandomEpisodesIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	episodes, err := client.RandomEpisodes(context.Background(), &RandomEpisodesParams{Max: 5})
+	if err != nil {
+		t.Fatalf("RandomEpisodes failed: %v", err)
+	}
+	if len(*episodes) == 0 {
+		t.Fatalf("No random episodes found")
+	}
+}
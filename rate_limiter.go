@@ -0,0 +1,21 @@
+package podcastindex
+
+import "github.com/jjgmckenzie/podcastindex/internal"
+
+// RateLimiter is a token bucket limiting the rate of requests made to the PodcastIndex API; set it with
+// NewClientOptions.RateLimiter.
+//
+// Every request made by a Client passes through its RateLimiter, including retries, blocking until the limiter
+// lets it through; if that would be after the request's context deadline, the request fails straight away instead.
+// A RateLimiter is safe for concurrent use, and should be shared between all the Clients using the same API key.
+// Its Stats method reports how many requests are queued and how long they are waiting.
+type RateLimiter = internal.RateLimiter
+
+// RateLimiterStats is a snapshot of the state of a RateLimiter, returned by RateLimiter.Stats.
+type RateLimiterStats = internal.RateLimiterStats
+
+// NewRateLimiter creates a RateLimiter allowing requestsPerSecond requests per second on average,
+// with bursts of up to burst requests. burst is raised to 1 if lower.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	return internal.NewRateLimiter(requestsPerSecond, burst)
+}
@@ -0,0 +1,39 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterSharedBetweenClients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status": "true", "feeds": []}`))
+	}))
+	t.Cleanup(server.Close)
+	serverURL, _ := url.Parse(server.URL)
+
+	limiter := NewRateLimiter(1.0/3600, 1)
+	newClient := func() *Client {
+		return NewClient(NewClientOptions{
+			UserAgent:   "podcastindex-go/testrunner",
+			BaseURL:     serverURL,
+			RateLimiter: limiter,
+		})
+	}
+	if _, err := newClient().Categories(context.Background()); err != nil {
+		t.Fatalf("expected the first request to succeed, got %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := newClient().Categories(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the second client to share the first client's limit, got %v", err)
+	}
+	if stats := limiter.Stats(); stats.Requests != 1 {
+		t.Errorf("expected 1 request through the limiter, got %+v", stats)
+	}
+}
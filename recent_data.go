@@ -0,0 +1,177 @@
+package podcastindex
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/episode"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+
+	"golang.org/x/text/language"
+)
+
+// RecentDataParams is a struct that contains the optional parameters for the RecentData method.
+//
+// The optional parameters are:
+type RecentDataParams struct {
+	// Max is the maximum number of feeds and episodes to return; default 5000, maximum 5000
+	Max int
+	// Since : If present, only feeds and episodes added to the index since this time will be returned.
+	//
+	// Use RecentData.NextSince from a previous call to continue where it left off.
+	Since time.Time
+}
+
+// RecentData is every new feed and episode added to the index, as returned by Client.RecentData.
+type RecentData struct {
+	// Feeds are the feeds added to the index. Only a subset of the Podcast fields are reported.
+	Feeds []*Podcast
+	// Episodes are the episodes added to the index. Only a subset of the Episode fields are reported.
+	Episodes []Episode
+	// NextSince is the cursor to pass as RecentDataParams.Since to fetch the data added after this batch.
+	NextSince time.Time
+}
+
+// recentDataResponse is the response from the recent/data endpoint on a 200/OK response.
+type recentDataResponse struct {
+	// Status indicates API request status; either "true" or "false"
+	Status    string `json:"status"`
+	FeedCount int    `json:"feedCount"`
+	ItemCount int    `json:"itemCount"`
+	Since     int64  `json:"since"`
+	NextSince int64  `json:"nextSince"`
+	Data      struct {
+		Feeds []recentDataFeedJSON `json:"feeds"`
+		Items []recentDataItemJSON `json:"items"`
+	} `json:"data"`
+	//  Description is the description of the response
+	Description string `json:"description"`
+}
+
+// recentDataFeedJSON is an intermediary struct for the feeds reported by the recent/data endpoint,
+// which prefixes every field with "feed" rather than using the usual Podcast field names.
+type recentDataFeedJSON struct {
+	FeedID          int    `json:"feedId"`
+	FeedGUID        string `json:"feedGuid"`
+	FeedURL         string `json:"feedUrl"`
+	FeedTitle       string `json:"feedTitle"`
+	FeedDescription string `json:"feedDescription"`
+	FeedImage       string `json:"feedImage"`
+	FeedLanguage    string `json:"feedLanguage"`
+	FeedITunesID    *int   `json:"feedItunesId"`
+}
+
+// recentDataItemJSON is an intermediary struct for the episodes reported by the recent/data endpoint,
+// which prefixes every field with "episode" rather than using the usual Episode field names.
+type recentDataItemJSON struct {
+	EpisodeID              int     `json:"episodeId"`
+	EpisodeTitle           string  `json:"episodeTitle"`
+	EpisodeDescription     string  `json:"episodeDescription"`
+	EpisodeImage           string  `json:"episodeImage"`
+	EpisodeTimestamp       int64   `json:"episodeTimestamp"`
+	EpisodeAdded           int64   `json:"episodeAdded"`
+	EpisodeEnclosureURL    string  `json:"episodeEnclosureUrl"`
+	EpisodeEnclosureLength int     `json:"episodeEnclosureLength"`
+	EpisodeEnclosureType   string  `json:"episodeEnclosureType"`
+	EpisodeDuration        *int    `json:"episodeDuration"`
+	EpisodeType            *string `json:"episodeType"`
+	FeedID                 int     `json:"feedId"`
+}
+
+// podcast converts the recent/data feed into a Podcast.
+func (f recentDataFeedJSON) podcast() (*Podcast, error) {
+	p := &Podcast{
+		ID:          podcast.ID(f.FeedID),
+		GUID:        podcast.GUID(f.FeedGUID),
+		Title:       f.FeedTitle,
+		Description: f.FeedDescription,
+		Language:    language.Make(f.FeedLanguage),
+	}
+	if f.FeedITunesID != nil {
+		p.ITunesID = podcast.ITunesID(strconv.Itoa(*f.FeedITunesID))
+	}
+	parsedURL, err := url.Parse(f.FeedURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL '%s': %w", f.FeedURL, err)
+	}
+	p.URL = *parsedURL
+	parsedURL, err = url.Parse(f.FeedImage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Image URL '%s': %w", f.FeedImage, err)
+	}
+	p.Image = *parsedURL
+	return p, nil
+}
+
+// episode converts the recent/data item into an Episode.
+func (i recentDataItemJSON) episode() (Episode, error) {
+	e := Episode{
+		ID:              episode.ID(i.EpisodeID),
+		Title:           i.EpisodeTitle,
+		Description:     i.EpisodeDescription,
+		DatePublished:   time.Unix(i.EpisodeTimestamp, 0),
+		DateCrawled:     time.Unix(i.EpisodeAdded, 0),
+		EnclosureType:   i.EpisodeEnclosureType,
+		EnclosureLength: i.EpisodeEnclosureLength,
+		Duration:        i.EpisodeDuration,
+		FeedID:          podcast.ID(i.FeedID),
+	}
+	if i.EpisodeType != nil {
+		episodeType := episode.EpisodeType(*i.EpisodeType)
+		e.EpisodeType = &episodeType
+	}
+	parsedURL, err := url.Parse(i.EpisodeEnclosureURL)
+	if err != nil {
+		return Episode{}, fmt.Errorf("failed to parse EnclosureURL '%s': %w", i.EpisodeEnclosureURL, err)
+	}
+	e.EnclosureURL = *parsedURL
+	parsedURL, err = url.Parse(i.EpisodeImage)
+	if err != nil {
+		return Episode{}, fmt.Errorf("failed to parse Image URL '%s': %w", i.EpisodeImage, err)
+	}
+	e.Image = *parsedURL
+	return e, nil
+}
+
+// RecentData returns every new feed and episode added to the index over the past 24 hours, in reverse chronological order.
+//
+// Also accepts optional parameters to filter the results, see RecentDataParams for more details.
+func (c *Client) RecentData(ctx context.Context, params *RecentDataParams) (*RecentData, error) {
+	var response recentDataResponse
+	urlParams := url.Values{}
+	if params != nil {
+		if params.Max != 0 {
+			urlParams.Set("max", strconv.Itoa(params.Max))
+		}
+		if !params.Since.IsZero() {
+			urlParams.Add("since", unixTime(params.Since))
+		}
+	}
+	err := c.api.Get(ctx, "/recent/data", urlParams, &response)
+	if err != nil {
+		return nil, err
+	}
+	data := &RecentData{
+		Feeds:     make([]*Podcast, 0, len(response.Data.Feeds)),
+		Episodes:  make([]Episode, 0, len(response.Data.Items)),
+		NextSince: time.Unix(response.NextSince, 0),
+	}
+	for _, feed := range response.Data.Feeds {
+		p, err := feed.podcast()
+		if err != nil {
+			return nil, err
+		}
+		data.Feeds = append(data.Feeds, p)
+	}
+	for _, item := range response.Data.Items {
+		e, err := item.episode()
+		if err != nil {
+			return nil, err
+		}
+		data.Episodes = append(data.Episodes, e)
+	}
+	return data, nil
+}
@@ -0,0 +1,82 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/episode"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// TestRecentDataRequest verifies that the client sends the correct
+// request path and query parameters for the RecentData method.
+func TestRecentDataRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		params := RecentDataParams{
+			Max:   5,
+			Since: time.Unix(1613805000, 0),
+		}
+
+		expectedQuery := url.Values{
+			"max":   {"5"},
+			"since": {"1613805000"},
+		}
+
+		_, _ = client.RecentData(context.Background(), &params)
+		if err := searchServer.ExpectPathAndQuery("/recent/data", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client converts feeds and items into podcasts and episodes", func(t *testing.T) {
+		client := GetJSONServer(t, `{
+			"status": "true",
+			"feedCount": 1,
+			"itemCount": 1,
+			"since": 1613805000,
+			"nextSince": 1613805123,
+			"data": {
+				"feeds": [{"feedId": 75075, "feedGuid": "guid", "feedUrl": "https://example.com/feed.xml", "feedTitle": "Feed", "feedLanguage": "en", "feedItunesId": 1234}],
+				"items": [{"episodeId": 42, "episodeTitle": "Episode", "episodeEnclosureUrl": "https://example.com/episode.mp3", "episodeTimestamp": 1613805100, "feedId": 75075}]
+			},
+			"description": "Found matching items."
+		}`)
+
+		data, err := client.RecentData(context.Background(), nil)
+		if err != nil {
+			t.Fatalf("RecentData failed: %v", err)
+		}
+		if !data.NextSince.Equal(time.Unix(1613805123, 0)) {
+			t.Errorf("expected NextSince 1613805123, got %d", data.NextSince.Unix())
+		}
+		if len(data.Feeds) != 1 || data.Feeds[0].ID != podcast.ID(75075) || data.Feeds[0].ITunesID != "1234" {
+			t.Errorf("unexpected feeds: %+v", data.Feeds)
+		}
+		if len(data.Episodes) != 1 || data.Episodes[0].ID != episode.ID(42) || data.Episodes[0].EnclosureURL.Host != "example.com" {
+			t.Errorf("unexpected episodes: %+v", data.Episodes)
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).RecentData(context.Background(), nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestRecentDataIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	_, err := client.RecentData(context.Background(), &RecentDataParams{Max: 5})
+	if err != nil {
+		t.Fatalf("RecentData failed: %v", err)
+	}
+}
@@ -0,0 +1,52 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/jjgmckenzie/podcastindex/episode"
+)
+
+// RecentEpisodesParams is a struct that contains the optional parameters for the RecentEpisodes method.
+//
+// The optional parameters are:
+type RecentEpisodesParams struct {
+	// Max is the maximum number of episodes to return; default 10, maximum 1000
+	Max int
+	// ExcludeString : If present, episodes with this string in the title or url will not be returned.
+	ExcludeString string
+	// Before : If present, only episodes added to the index before this episode.ID will be returned.
+	//
+	// Use the ID of the last episode returned by a previous call to page through older episodes.
+	Before episode.ID
+	// FullText : If present, return the full text value of any text fields (ex: description). If not provided, field value is truncated to 100 words.
+	FullText bool
+}
+
+// RecentEpisodes returns the most recent episodes added to the index, in reverse chronological order.
+//
+// Also accepts optional parameters to filter the results, see RecentEpisodesParams for more details.
+func (c *Client) RecentEpisodes(ctx context.Context, params *RecentEpisodesParams) (*[]Episode, error) {
+	var response getEpisodeResponse
+	urlParams := url.Values{"max": {"10"}}
+	if params != nil {
+		if params.Max != 0 {
+			urlParams.Set("max", strconv.Itoa(params.Max))
+		}
+		if params.ExcludeString != "" {
+			urlParams.Add("excludeString", params.ExcludeString)
+		}
+		if params.Before != 0 {
+			urlParams.Add("before", strconv.Itoa(int(params.Before)))
+		}
+		if params.FullText {
+			urlParams.Add("fulltext", "")
+		}
+	}
+	err := c.api.Get(ctx, "/recent/episodes", urlParams, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Items, nil
+}
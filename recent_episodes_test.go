@@ -0,0 +1,57 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+)
+
+// TestRecentEpisodesRequest verifies that the client sends the correct
+// request path and query parameters for the RecentEpisodes method.
+func TestRecentEpisodesRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		params := RecentEpisodesParams{
+			Max:           5,
+			ExcludeString: "trailer",
+			Before:        testEpisodeID,
+			FullText:      true,
+		}
+
+		expectedQuery := url.Values{
+			"max":           {"5"},
+			"excludeString": {"trailer"},
+			"before":        {"43141716087"},
+			"fulltext":      {},
+		}
+
+		_, _ = client.RecentEpisodes(context.Background(), &params)
+		if err := searchServer.ExpectPathAndQuery("/recent/episodes", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).RecentEpisodes(context.Background(), nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestRecentEpisodesIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	episodes, err := client.RecentEpisodes(context.Background(), &RecentEpisodesParams{Max: 5})
+	if err != nil {
+		t.Fatalf("RecentEpisodes failed: %v", err)
+	}
+	if len(*episodes) == 0 {
+		t.Fatalf("No recent episodes found")
+	}
+}
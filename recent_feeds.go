@@ -0,0 +1,60 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+
+	"golang.org/x/text/language"
+)
+
+// RecentFeedsParams is a struct that contains the optional parameters for the RecentFeeds method.
+//
+// The optional parameters are:
+type RecentFeedsParams struct {
+	// Max is the maximum number of podcasts to return; default 40, maximum 1000
+	Max int
+	// Since : If present, only feeds updated since this time will be returned.
+	Since time.Time
+	// Languages : If present, only feeds in one of these languages will be returned.
+	Languages []language.Tag
+	// Categories : If present, only feeds in one of these categories will be returned.
+	//
+	// Accepts podcast.Category or podcast.CategoryID values; all categories are returned by Client.Categories.
+	Categories []podcast.CategoryFilter
+	// ExcludeCategories : If present, feeds in any of these categories will not be returned.
+	ExcludeCategories []podcast.CategoryFilter
+}
+
+// RecentFeeds returns the most recently updated feeds in the index, in reverse chronological order.
+//
+// Also accepts optional parameters to filter the results, see RecentFeedsParams for more details.
+func (c *Client) RecentFeeds(ctx context.Context, params *RecentFeedsParams) ([]*Podcast, error) {
+	var response searchResponse
+	urlParams := url.Values{}
+	if params != nil {
+		if params.Max != 0 {
+			urlParams.Set("max", strconv.Itoa(params.Max))
+		}
+		if !params.Since.IsZero() {
+			urlParams.Add("since", unixTime(params.Since))
+		}
+		if len(params.Languages) != 0 {
+			urlParams.Add("lang", joinLanguages(params.Languages))
+		}
+		if len(params.Categories) != 0 {
+			urlParams.Add("cat", joinCategories(params.Categories))
+		}
+		if len(params.ExcludeCategories) != 0 {
+			urlParams.Add("notcat", joinCategories(params.ExcludeCategories))
+		}
+	}
+	err := c.api.Get(ctx, "/recent/feeds", urlParams, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Feeds, nil
+}
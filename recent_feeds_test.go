@@ -0,0 +1,64 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+
+	"golang.org/x/text/language"
+)
+
+// TestRecentFeedsRequest verifies that the client sends the correct
+// request path and query parameters for the RecentFeeds method.
+func TestRecentFeedsRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		params := RecentFeedsParams{
+			Max:               5,
+			Since:             time.Unix(1613805000, 0),
+			Languages:         []language.Tag{language.English, language.BrazilianPortuguese},
+			Categories:        []podcast.CategoryFilter{podcast.Category{ID: 9, Name: "Business"}, podcast.CategoryID(11)},
+			ExcludeCategories: []podcast.CategoryFilter{podcast.CategoryID(86)},
+		}
+
+		expectedQuery := url.Values{
+			"max":    {"5"},
+			"since":  {"1613805000"},
+			"lang":   {"en,pt-br"},
+			"cat":    {"9,11"},
+			"notcat": {"86"},
+		}
+
+		_, _ = client.RecentFeeds(context.Background(), &params)
+		if err := searchServer.ExpectPathAndQuery("/recent/feeds", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).RecentFeeds(context.Background(), nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestRecentFeedsIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	feeds, err := client.RecentFeeds(context.Background(), &RecentFeedsParams{Max: 5})
+	if err != nil {
+		t.Fatalf("RecentFeeds failed: %v", err)
+	}
+	if len(feeds) == 0 {
+		t.Fatalf("No recent feeds found")
+	}
+}
@@ -0,0 +1,55 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// RecentNewFeedsParams is a struct that contains the optional parameters for the RecentNewFeeds method.
+//
+// The optional parameters are:
+type RecentNewFeedsParams struct {
+	// Max is the maximum number of podcasts to return; default 60, maximum 1000
+	Max int
+	// Since : If present, only feeds added to the index since this time will be returned.
+	Since time.Time
+	// FeedID : If present, start the list of feeds from this podcast.ID, instead of the most recently added feed.
+	FeedID podcast.ID
+	// Desc : If present, return the feeds in descending order of the time they were added to the index.
+	//
+	// Only applies when Since is also set.
+	Desc bool
+}
+
+// RecentNewFeeds returns the feeds that have most recently been added to the index.
+//
+// Only a subset of the Podcast fields are reported by the API for this endpoint.
+//
+// Also accepts optional parameters to filter the results, see RecentNewFeedsParams for more details.
+func (c *Client) RecentNewFeeds(ctx context.Context, params *RecentNewFeedsParams) ([]*Podcast, error) {
+	var response searchResponse
+	urlParams := url.Values{}
+	if params != nil {
+		if params.Max != 0 {
+			urlParams.Set("max", strconv.Itoa(params.Max))
+		}
+		if !params.Since.IsZero() {
+			urlParams.Add("since", unixTime(params.Since))
+		}
+		if params.FeedID != 0 {
+			urlParams.Add("feedid", strconv.Itoa(int(params.FeedID)))
+		}
+		if params.Desc {
+			urlParams.Add("desc", "")
+		}
+	}
+	err := c.api.Get(ctx, "/recent/newfeeds", urlParams, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Feeds, nil
+}
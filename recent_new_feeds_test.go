@@ -0,0 +1,58 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// TestRecentNewFeedsRequest verifies that the client sends the correct
+// request path and query parameters for the RecentNewFeeds method.
+func TestRecentNewFeedsRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		params := RecentNewFeedsParams{
+			Max:    5,
+			Since:  time.Unix(1613805000, 0),
+			FeedID: testValidFeedID,
+			Desc:   true,
+		}
+
+		expectedQuery := url.Values{
+			"max":    {"5"},
+			"since":  {"1613805000"},
+			"feedid": {"75075"},
+			"desc":   {},
+		}
+
+		_, _ = client.RecentNewFeeds(context.Background(), &params)
+		if err := searchServer.ExpectPathAndQuery("/recent/newfeeds", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).RecentNewFeeds(context.Background(), nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestRecentNewFeedsIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	feeds, err := client.RecentNewFeeds(context.Background(), &RecentNewFeedsParams{Max: 5})
+	if err != nil {
+		t.Fatalf("RecentNewFeeds failed: %v", err)
+	}
+	if len(feeds) == 0 {
+		t.Fatalf("No recent new feeds found")
+	}
+}
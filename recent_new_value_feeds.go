@@ -0,0 +1,39 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RecentNewValueFeedsParams is a struct that contains the optional parameters for the RecentNewValueFeeds method.
+//
+// The optional parameters are:
+type RecentNewValueFeedsParams struct {
+	// Max is the maximum number of podcasts to return; default 1000, maximum 5000
+	Max int
+	// Since : If present, only feeds whose value block was added to the index since this time will be returned.
+	Since time.Time
+}
+
+// RecentNewValueFeeds returns the feeds which most recently added a "Value for Value" block, in reverse chronological order.
+//
+// Also accepts optional parameters to filter the results, see RecentNewValueFeedsParams for more details.
+func (c *Client) RecentNewValueFeeds(ctx context.Context, params *RecentNewValueFeedsParams) ([]*Podcast, error) {
+	var response searchResponse
+	urlParams := url.Values{}
+	if params != nil {
+		if params.Max != 0 {
+			urlParams.Set("max", strconv.Itoa(params.Max))
+		}
+		if !params.Since.IsZero() {
+			urlParams.Add("since", unixTime(params.Since))
+		}
+	}
+	err := c.api.Get(ctx, "/recent/newvaluefeeds", urlParams, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Feeds, nil
+}
@@ -0,0 +1,54 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// TestRecentNewValueFeedsRequest verifies that the client sends the correct
+// request path and query parameters for the RecentNewValueFeeds method.
+func TestRecentNewValueFeedsRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		params := RecentNewValueFeedsParams{
+			Max:   5,
+			Since: time.Unix(1613805000, 0),
+		}
+
+		expectedQuery := url.Values{
+			"max":   {"5"},
+			"since": {"1613805000"},
+		}
+
+		_, _ = client.RecentNewValueFeeds(context.Background(), &params)
+		if err := searchServer.ExpectPathAndQuery("/recent/newvaluefeeds", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).RecentNewValueFeeds(context.Background(), nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestRecentNewValueFeedsIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	feeds, err := client.RecentNewValueFeeds(context.Background(), &RecentNewValueFeedsParams{Max: 5})
+	if err != nil {
+		t.Fatalf("RecentNewValueFeeds failed: %v", err)
+	}
+	if len(feeds) == 0 {
+		t.Fatalf("No recent new value feeds found")
+	}
+}
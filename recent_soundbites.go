@@ -0,0 +1,98 @@
+package podcastindex
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/jjgmckenzie/podcastindex/episode"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// RecentSoundbitesParams is a struct that contains the optional parameters for the RecentSoundbites method.
+//
+// The optional parameters are:
+type RecentSoundbitesParams struct {
+	// Max is the maximum number of soundbites to return; default 60, maximum 1000
+	Max int
+}
+
+// RecentSoundbite is a soundbite recently added to the index, along with the episode it was taken from.
+type RecentSoundbite struct {
+	// Soundbite is the soundbite itself
+	Soundbite episode.Soundbite
+	// Episode is the episode the soundbite belongs to.
+	//
+	// Only the ID, Title, EnclosureURL, FeedID and FeedURL fields are reported.
+	Episode Episode
+	// FeedTitle is the name of the feed the episode belongs to
+	FeedTitle string
+}
+
+// recentSoundbitesResponse is the response from the recent/soundbites endpoint on a 200/OK response.
+type recentSoundbitesResponse struct {
+	// Status indicates API request status; either "true" or "false"
+	Status string                `json:"status"`
+	Items  []recentSoundbiteJSON `json:"items"`
+	Count  int                   `json:"count"`
+	//  Description is the description of the response
+	Description string `json:"description"`
+}
+
+// recentSoundbiteJSON is an intermediary struct for the soundbites reported by the recent/soundbites endpoint.
+type recentSoundbiteJSON struct {
+	EnclosureURL string `json:"enclosureUrl"`
+	Title        string `json:"title"`
+	StartTime    int    `json:"startTime"`
+	Duration     int    `json:"duration"`
+	EpisodeID    int    `json:"episodeId"`
+	EpisodeTitle string `json:"episodeTitle"`
+	FeedTitle    string `json:"feedTitle"`
+	FeedURL      string `json:"feedUrl"`
+	FeedID       int    `json:"feedId"`
+}
+
+// RecentSoundbites returns the soundbites most recently added to the index, in reverse chronological order.
+//
+// Also accepts optional parameters to filter the results, see RecentSoundbitesParams for more details.
+func (c *Client) RecentSoundbites(ctx context.Context, params *RecentSoundbitesParams) ([]RecentSoundbite, error) {
+	var response recentSoundbitesResponse
+	urlParams := url.Values{}
+	if params != nil {
+		if params.Max != 0 {
+			urlParams.Set("max", strconv.Itoa(params.Max))
+		}
+	}
+	err := c.api.Get(ctx, "/recent/soundbites", urlParams, &response)
+	if err != nil {
+		return nil, err
+	}
+	soundbites := make([]RecentSoundbite, 0, len(response.Items))
+	for _, item := range response.Items {
+		enclosureURL, err := url.Parse(item.EnclosureURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse EnclosureURL '%s': %w", item.EnclosureURL, err)
+		}
+		feedURL, err := url.Parse(item.FeedURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse FeedURL '%s': %w", item.FeedURL, err)
+		}
+		soundbites = append(soundbites, RecentSoundbite{
+			Soundbite: episode.Soundbite{
+				StartTime: item.StartTime,
+				Duration:  item.Duration,
+				Title:     item.Title,
+			},
+			Episode: Episode{
+				ID:           episode.ID(item.EpisodeID),
+				Title:        item.EpisodeTitle,
+				EnclosureURL: *enclosureURL,
+				FeedID:       podcast.ID(item.FeedID),
+				FeedURL:      *feedURL,
+			},
+			FeedTitle: item.FeedTitle,
+		})
+	}
+	return soundbites, nil
+}
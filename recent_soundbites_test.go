@@ -0,0 +1,70 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+)
+
+// TestRecentSoundbitesRequest verifies that the client sends the correct
+// request path and query parameters for the RecentSoundbites method.
+func TestRecentSoundbitesRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		expectedQuery := url.Values{
+			"max": {"5"},
+		}
+
+		_, _ = client.RecentSoundbites(context.Background(), &RecentSoundbitesParams{Max: 5})
+		if err := searchServer.ExpectPathAndQuery("/recent/soundbites", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client converts items into soundbites", func(t *testing.T) {
+		client := GetJSONServer(t, `{
+			"status": "true",
+			"items": [{"enclosureUrl": "https://example.com/episode.mp3", "title": "Bite", "startTime": 1234, "duration": 30, "episodeId": 42, "episodeTitle": "Episode", "feedTitle": "Feed", "feedUrl": "https://example.com/feed.xml", "feedId": 75075}],
+			"count": 1,
+			"description": "Found matching soundbites."
+		}`)
+
+		soundbites, err := client.RecentSoundbites(context.Background(), nil)
+		if err != nil {
+			t.Fatalf("RecentSoundbites failed: %v", err)
+		}
+		if len(soundbites) != 1 {
+			t.Fatalf("expected 1 soundbite, got %d", len(soundbites))
+		}
+		bite := soundbites[0]
+		if bite.Soundbite.StartTime != 1234 || bite.Soundbite.Duration != 30 || bite.Soundbite.Title != "Bite" {
+			t.Errorf("unexpected soundbite: %+v", bite.Soundbite)
+		}
+		if bite.Episode.ID != 42 || bite.Episode.FeedID != 75075 || bite.FeedTitle != "Feed" {
+			t.Errorf("unexpected episode: %+v", bite)
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).RecentSoundbites(context.Background(), nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestRecentSoundbitesIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	soundbites, err := client.RecentSoundbites(context.Background(), &RecentSoundbitesParams{Max: 5})
+	if err != nil {
+		t.Fatalf("RecentSoundbites failed: %v", err)
+	}
+	if len(soundbites) == 0 {
+		t.Fatalf("No recent soundbites found")
+	}
+}
@@ -1,5 +1,7 @@
 package podcastindex
 
+import "github.com/jjgmckenzie/podcastindex/podcast"
+
 type searchResponse struct {
 	Status      string     `json:"status"`
 	Feeds       []*Podcast `json:"feeds"`
@@ -32,3 +34,12 @@ type getEpisodeResponse struct {
 	//  Description is the description of the response
 	Description string `json:"description"`
 }
+
+type valueResponse struct {
+	// Status indicates API request status; either "true" or "false"
+	Status string `json:"status"`
+	// Value is the "Value for Value" information for the podcast or episode - this is the response.
+	Value podcast.Value `json:"value"`
+	//  Description is the description of the response
+	Description string `json:"description"`
+}
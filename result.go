@@ -0,0 +1,48 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jjgmckenzie/podcastindex/internal"
+)
+
+// ResponseMetadata is the metadata of a response from the PodcastIndex API: the status, count, query and description
+// of its envelope, which the methods of Client otherwise discard, and the URL of the request, the response headers
+// and how long the response took.
+type ResponseMetadata = internal.ResponseMetadata
+
+// ErrMultipleResponses is returned by WithMetadata when the call it wraps makes more than one request, e.g. a bulk
+// lookup or an iterator, as no single response's metadata describes the result. Check for it with errors.Is.
+var ErrMultipleResponses = errors.New("the call made more than one request")
+
+// Result is the result of a call to a Client method, with the metadata of the response it was decoded from.
+type Result[T any] struct {
+	// Items is the result of the call, e.g. the podcasts found by a search.
+	Items T
+	ResponseMetadata
+}
+
+// WithMetadata makes a call to a Client method with a context collecting the metadata of its response, and returns
+// its result along with the metadata. For example, to get the query and count of a search:
+//
+//	result, err := podcastindex.WithMetadata(ctx, func(ctx context.Context) ([]*podcastindex.Podcast, error) {
+//		return client.SearchPodcastsByTerm(ctx, "batman", nil)
+//	})
+//	fmt.Println(result.QueryString(), result.Count, len(result.Items))
+//
+// The call must make a single request: if it makes several, e.g. a bulk lookup or an iterator, its result is returned
+// without metadata, and the error wraps ErrMultipleResponses, along with the call's own error, if any. Retries and
+// coalesced requests count as a single request, and Elapsed includes the time spent on them.
+// If the call fails, the metadata of the failed response, if there was one, is returned along with the error.
+func WithMetadata[T any](ctx context.Context, call func(ctx context.Context) (T, error)) (Result[T], error) {
+	var collector internal.MetadataCollector
+	items, err := call(internal.WithMetadataCollector(ctx, &collector))
+	metadata, responses := collector.Collected()
+	if responses > 1 {
+		multipleErr := fmt.Errorf("%w: WithMetadata can only wrap a call making a single request, but it made %d", ErrMultipleResponses, responses)
+		return Result[T]{Items: items}, errors.Join(multipleErr, err)
+	}
+	return Result[T]{Items: items, ResponseMetadata: metadata}, err
+}
@@ -0,0 +1,62 @@
+package podcastindex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+func TestWithMetadata(t *testing.T) {
+	client := GetJSONServer(t, `{"status": "true", "feeds": [{"id": 75075, "title": "Batman University"}], "count": 1, "query": "batman", "description": "Found matching feeds"}`)
+
+	result, err := WithMetadata(context.Background(), func(ctx context.Context) ([]*Podcast, error) {
+		return client.SearchPodcastsByTerm(ctx, "batman", nil)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Items) != 1 || result.Items[0].Title != "Batman University" {
+		t.Errorf("unexpected items %v", result.Items)
+	}
+	if result.Status != "true" || result.Count != 1 || result.QueryString() != "batman" || result.Description != "Found matching feeds" {
+		t.Errorf("unexpected envelope fields %+v", result.ResponseMetadata)
+	}
+	if !strings.HasSuffix(result.URL, "/search/byterm?max=10&q=batman") {
+		t.Errorf("unexpected URL %s", result.URL)
+	}
+	if result.StatusCode != http.StatusOK || result.Header.Get("Content-Type") != "application/json" || result.Elapsed <= 0 {
+		t.Errorf("unexpected response details %+v", result.ResponseMetadata)
+	}
+}
+
+func TestWithMetadataError(t *testing.T) {
+	client := GetStatusServer(t, http.StatusTooManyRequests)
+
+	result, err := WithMetadata(context.Background(), func(ctx context.Context) ([]podcast.Category, error) {
+		return client.Categories(ctx)
+	})
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+	if result.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected the metadata of the failed response, got %+v", result.ResponseMetadata)
+	}
+}
+
+func TestWithMetadataRejectsMultipleRequests(t *testing.T) {
+	client := GetJSONServer(t, `{"status": "true", "feed": {"id": 75075, "title": "Batman University"}}`)
+
+	result, err := WithMetadata(context.Background(), func(ctx context.Context) ([]BulkResult[podcast.ID, *Podcast], error) {
+		return client.GetPodcastsByFeedIDs(ctx, []podcast.ID{75075, 920666}, nil), nil
+	})
+	if !errors.Is(err, ErrMultipleResponses) {
+		t.Fatalf("expected ErrMultipleResponses, got %v", err)
+	}
+	if len(result.Items) != 2 || result.URL != "" {
+		t.Errorf("expected the items without metadata, got %+v", result)
+	}
+}
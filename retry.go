@@ -0,0 +1,39 @@
+package podcastindex
+
+import (
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/internal"
+)
+
+// RetryPolicy configures how failed requests to the PodcastIndex API are retried; set it with
+// NewClientOptions.RetryPolicy.
+//
+// Each attempt is a new request, signed with a fresh X-Auth-Date. Delays grow exponentially from BaseDelay,
+// capped at MaxDelay, unless the API responds with a Retry-After header, which is honored instead.
+// Retrying stops early if the request's context is cancelled, or its deadline falls before the next attempt.
+//
+// By default, 429 Too Many Requests, 500, 502, 503 and 504 responses are retried, as are errors reaching the API,
+// such as connection resets; see DefaultRetryableStatuses and IsTransientError.
+type RetryPolicy = internal.RetryPolicy
+
+// DefaultRetryableStatuses are the status codes retried when a RetryPolicy does not set RetryableStatuses.
+var DefaultRetryableStatuses = internal.DefaultRetryableStatuses
+
+// IsTransientError reports whether err is a failure to reach the API, or to read its response, that may succeed
+// if retried, such as a timeout or a refused or reset connection. TLS certificate errors, unknown hosts, malformed URLs,
+// and context cancellation and deadlines are not transient.
+func IsTransientError(err error) bool {
+	return internal.IsTransientError(err)
+}
+
+// DefaultRetryPolicy returns a RetryPolicy suitable for most uses: up to 4 attempts, with delays starting at
+// half a second and capped at 30 seconds, half of which is randomized.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+		Jitter:      0.5,
+	}
+}
@@ -0,0 +1,39 @@
+package podcastindex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status": "true", "feeds": [{"id": 1, "name": "Arts"}]}`))
+	}))
+	t.Cleanup(server.Close)
+	serverURL, _ := url.Parse(server.URL)
+
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	client := NewClient(NewClientOptions{
+		UserAgent:   "podcastindex-go/testrunner",
+		BaseURL:     serverURL,
+		RetryPolicy: policy,
+	})
+	categories, err := client.Categories(context.Background())
+	if err != nil {
+		t.Fatalf("expected the retried request to succeed, got %v", err)
+	}
+	if attempts != 2 || len(categories) != 1 {
+		t.Errorf("expected 2 attempts and 1 category, got %d attempts and %v", attempts, categories)
+	}
+}
@@ -2,7 +2,7 @@ package podcastindex
 
 import (
 	"context"
-	"log"
+	"net/http"
 	"net/url"
 	"strconv"
 )
@@ -28,17 +28,24 @@ type SearchPodcastsByTitleParams struct {
 // SearchPodcastsByTitle returns a list of podcasts that match the given title.
 //
 // Also accepts optional parameters to filter the results, see SearchPodcastsByTitleParams for more details.
+// A Max of 100 or more is sent as 99, raising a WarningMaxTruncated warning; params is never modified.
 func (c *Client) SearchPodcastsByTitle(ctx context.Context, title string, params *SearchPodcastsByTitleParams) ([]*Podcast, error) {
 	var response searchResponse
 	urlParams := url.Values{"q": {title}, "max": {"10"}}
 	if params != nil {
 		if params.Max != 0 {
-			if params.Max >= 100 {
+			maxResults := params.Max
+			if maxResults >= 100 {
 				// Warn the user that despite a stated max of 1000, the API only supports a max of 99; https://github.com/Podcastindex-org/docs-api/issues/141
-				params.Max = 99
-				log.Printf("Warning: despite a stated max of 1000, the API only supports a max of 99 for this endpoint; see https://github.com/Podcastindex-org/docs-api/issues/141. Truncating max to 99.")
+				maxResults = 99
+				c.warn(ctx, http.MethodGet, Warning{
+					Code:      WarningMaxTruncated,
+					Endpoint:  "/search/bytitle",
+					Message:   "despite a stated max of 1000, the API only supports a max of 99 for this endpoint. Truncating max to 99",
+					Reference: "https://github.com/Podcastindex-org/docs-api/issues/141",
+				})
 			}
-			urlParams.Set("max", strconv.Itoa(params.Max))
+			urlParams.Set("max", strconv.Itoa(maxResults))
 		}
 		if params.Clean {
 			urlParams.Add("clean", "")
@@ -1,9 +1,12 @@
 package podcastindex
 
 import (
+	"bytes"
 	"context"
 	"github.com/jjgmckenzie/podcastindex/podcast/value"
+	"log/slog"
 	"net/url"
+	"strings"
 	"testing"
 )
 
@@ -83,6 +86,59 @@ func TestGetPodcastsByTitleRequest(t *testing.T) {
 		}
 	})
 
+	t.Run("truncating Max raises a warning and does not modify params", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		var logs bytes.Buffer
+		warnings := make(chan Warning, 1)
+		client := NewClient(NewClientOptions{
+			BaseURL:  serverURL,
+			Logger:   slog.New(slog.NewJSONHandler(&logs, nil)),
+			Warnings: warnings,
+		})
+
+		params := SearchPodcastsByTitleParams{Max: 500}
+		_, _ = client.SearchPodcastsByTitle(context.Background(), "batman university", &params)
+
+		if params.Max != 500 {
+			t.Errorf("Expected params.Max to be left as 500, got %d", params.Max)
+		}
+		select {
+		case warning := <-warnings:
+			if warning.Code != WarningMaxTruncated || warning.Endpoint != "/search/bytitle" {
+				t.Errorf("Expected a max truncated warning for /search/bytitle, got %+v", warning)
+			}
+		default:
+			t.Errorf("Expected a warning to be sent")
+		}
+		for _, attr := range []string{`"code":"max_truncated"`, `"method":"GET"`, `"endpoint":"/search/bytitle"`} {
+			if !strings.Contains(logs.String(), attr) {
+				t.Errorf("Expected the warning to be logged with %s, got %s", attr, logs.String())
+			}
+		}
+	})
+
+	t.Run("warnings are collected per call", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{BaseURL: serverURL})
+
+		var truncated, notTruncated WarningCollector
+		_, _ = client.SearchPodcastsByTitle(WithWarningCollector(context.Background(), &truncated), "batman university", &SearchPodcastsByTitleParams{Max: 500})
+		_, _ = client.SearchPodcastsByTitle(WithWarningCollector(context.Background(), &notTruncated), "batman university", &SearchPodcastsByTitleParams{Max: 50})
+
+		if warnings := truncated.Warnings(); len(warnings) != 1 || warnings[0].Code != WarningMaxTruncated {
+			t.Errorf("Expected the call truncating Max to collect a max truncated warning, got %+v", warnings)
+		}
+		if warnings := notTruncated.Warnings(); len(warnings) != 0 {
+			t.Errorf("Expected the other call to collect no warnings, got %+v", warnings)
+		}
+	})
+
 	t.Run("the client errors if the server returns an error", func(t *testing.T) {
 		// Make sure an error is returned from the client when the server errors
 		_, err := GetErrorServer(t).SearchPodcastsByTitle(context.Background(), "error test", nil)
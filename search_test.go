@@ -136,3 +136,50 @@ func GetErrorServer(t *testing.T) *Client {
 		BaseURL: errorServerURL,
 	})
 }
+
+// GetJSONServer returns a Client for a httptest.Server which responds to every request with the given JSON body.
+func GetJSONServer(t *testing.T, body string) *Client {
+	t.Helper()
+	jsonServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(jsonServer.Close)
+
+	jsonServerURL, _ := url.Parse(jsonServer.URL)
+	return NewClient(NewClientOptions{
+		BaseURL: jsonServerURL,
+	})
+}
+
+// GetResponseServer returns a Client for a httptest.Server which responds to every request with the given status code
+// and JSON body.
+func GetResponseServer(t *testing.T, statusCode int, body string) *Client {
+	t.Helper()
+	responseServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(responseServer.Close)
+
+	responseServerURL, _ := url.Parse(responseServer.URL)
+	return NewClient(NewClientOptions{
+		BaseURL: responseServerURL,
+	})
+}
+
+// GetStatusServer returns a Client for a httptest.Server which responds to every request with the given status code.
+func GetStatusServer(t *testing.T, statusCode int) *Client {
+	t.Helper()
+	statusServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(statusServer.Close)
+
+	statusServerURL, _ := url.Parse(statusServer.URL)
+	return NewClient(NewClientOptions{
+		BaseURL: statusServerURL,
+	})
+}
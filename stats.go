@@ -0,0 +1,68 @@
+package podcastindex
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Stats are the statistics for the items in the index, as returned by Client.CurrentStats.
+//
+// https://podcastindex-org.github.io/docs-api/#tag--Stats
+type Stats struct {
+	// FeedCountTotal is the total number of feeds in the index.
+	FeedCountTotal int `json:"feedCountTotal"`
+	// EpisodeCountTotal is the total number of episodes in the index.
+	EpisodeCountTotal int `json:"episodeCountTotal"`
+	// FeedsWithNewEpisodes3Days is the number of feeds which published a new episode in the last 3 days.
+	FeedsWithNewEpisodes3Days int `json:"feedsWithNewEpisodes3days"`
+	// FeedsWithNewEpisodes10Days is the number of feeds which published a new episode in the last 10 days.
+	FeedsWithNewEpisodes10Days int `json:"feedsWithNewEpisodes10days"`
+	// FeedsWithNewEpisodes30Days is the number of feeds which published a new episode in the last 30 days.
+	FeedsWithNewEpisodes30Days int `json:"feedsWithNewEpisodes30days"`
+	// FeedsWithNewEpisodes90Days is the number of feeds which published a new episode in the last 90 days.
+	FeedsWithNewEpisodes90Days int `json:"feedsWithNewEpisodes90days"`
+	// FeedsWithValueBlocks is the number of feeds with a "Value for Value" block.
+	FeedsWithValueBlocks int `json:"feedsWithValueBlocks"`
+}
+
+// statsResponse is the response from the stats/current endpoint on a 200/OK response.
+type statsResponse struct {
+	// Status indicates API request status; either "true" or "false"
+	Status string `json:"status"`
+	// Stats is the statistics for the index - this is the response.
+	Stats Stats `json:"stats"`
+	//  Description is the description of the response
+	Description string `json:"description"`
+}
+
+// CurrentStats returns the current statistics for the items in the index.
+func (c *Client) CurrentStats(ctx context.Context) (*Stats, error) {
+	var response statsResponse
+	err := c.api.Get(ctx, "/stats/current", nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Stats, nil
+}
+
+// DailyCounts returns the statistics about the feeds in the index from the static daily counts file, which is
+// updated daily.
+//
+// The file is served from /static/stats/daily_counts.json on the API host rather than under BaseURL; the scheme and
+// host of a custom BaseURL are still honored. As the statistics reported by the file are not documented, counts are
+// returned keyed by their name in the file; values which are not whole numbers, e.g. timestamps, are left out.
+func (c *Client) DailyCounts(ctx context.Context) (map[string]int64, error) {
+	var response map[string]json.RawMessage
+	err := c.api.GetRoot(ctx, "/static/stats/daily_counts.json", nil, &response)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int64, len(response))
+	for name, value := range response {
+		var count *int64
+		if json.Unmarshal(value, &count) == nil && count != nil {
+			counts[name] = *count
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,128 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestCurrentStatsRequest(t *testing.T) {
+	t.Run("the client sends the correct request path", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		_, _ = client.CurrentStats(context.Background())
+		if err := searchServer.ExpectPathAndQuery("/stats/current", url.Values{}); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client returns the typed stats", func(t *testing.T) {
+		client := GetJSONServer(t, `{
+			"status": "true",
+			"stats": {
+				"feedCountTotal": 4200000,
+				"episodeCountTotal": 150000000,
+				"feedsWithNewEpisodes3days": 100000,
+				"feedsWithNewEpisodes10days": 200000,
+				"feedsWithNewEpisodes30days": 300000,
+				"feedsWithNewEpisodes90days": 400000,
+				"feedsWithValueBlocks": 50000
+			},
+			"description": "Stats"
+		}`)
+		stats, err := client.CurrentStats(context.Background())
+		if err != nil {
+			t.Fatalf("CurrentStats failed: %v", err)
+		}
+		expected := Stats{
+			FeedCountTotal:             4200000,
+			EpisodeCountTotal:          150000000,
+			FeedsWithNewEpisodes3Days:  100000,
+			FeedsWithNewEpisodes10Days: 200000,
+			FeedsWithNewEpisodes30Days: 300000,
+			FeedsWithNewEpisodes90Days: 400000,
+			FeedsWithValueBlocks:       50000,
+		}
+		if *stats != expected {
+			t.Errorf("expected %+v, got %+v", expected, *stats)
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).CurrentStats(context.Background())
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestDailyCountsRequest(t *testing.T) {
+	t.Run("the client requests the static file from the root of a custom base URL", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL + "/api/1.0/")
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		_, _ = client.DailyCounts(context.Background())
+		if err := searchServer.ExpectPathAndQuery("/static/stats/daily_counts.json", url.Values{}); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client keeps the path prefix of a custom base URL", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL + "/mock/api/1.0/")
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		_, _ = client.DailyCounts(context.Background())
+		if err := searchServer.ExpectPathAndQuery("/mock/static/stats/daily_counts.json", url.Values{}); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client returns the counts", func(t *testing.T) {
+		counts, err := GetJSONServer(t, `{"feedCountTotal": 4200000}`).DailyCounts(context.Background())
+		if err != nil {
+			t.Fatalf("DailyCounts failed: %v", err)
+		}
+		if counts["feedCountTotal"] != 4200000 {
+			t.Errorf("unexpected counts: %v", counts)
+		}
+	})
+	t.Run("values which are not counts are left out", func(t *testing.T) {
+		fixture, err := os.ReadFile("testdata/daily_counts_mixed.json")
+		if err != nil {
+			t.Fatalf("Failed to read mock JSON file: %v", err)
+		}
+		counts, err := GetJSONServer(t, string(fixture)).DailyCounts(context.Background())
+		if err != nil {
+			t.Fatalf("DailyCounts failed: %v", err)
+		}
+		expected := map[string]int64{"feedCountTotal": 4200000, "feedsWithNewMedia3days": 120000}
+		if !reflect.DeepEqual(counts, expected) {
+			t.Errorf("expected %v, got %v", expected, counts)
+		}
+	})
+}
+
+func TestCurrentStatsIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	stats, err := client.CurrentStats(context.Background())
+	if err != nil {
+		t.Fatalf("CurrentStats failed: %v", err)
+	}
+	if stats.FeedCountTotal == 0 {
+		t.Fatalf("expected a non-zero feed count")
+	}
+}
@@ -0,0 +1,60 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+
+	"golang.org/x/text/language"
+)
+
+// TrendingPodcastsParams is a struct that contains the optional parameters for the TrendingPodcasts method.
+//
+// The optional parameters are:
+type TrendingPodcastsParams struct {
+	// Max is the maximum number of podcasts to return; default 10, maximum 1000
+	Max int
+	// Since : If present, only podcasts trending since this time will be returned.
+	Since time.Time
+	// Languages : If present, only podcasts in one of these languages will be returned.
+	Languages []language.Tag
+	// Categories : If present, only podcasts in one of these categories will be returned.
+	//
+	// Accepts podcast.Category or podcast.CategoryID values; all categories are returned by Client.Categories.
+	Categories []podcast.CategoryFilter
+	// ExcludeCategories : If present, podcasts in any of these categories will not be returned.
+	ExcludeCategories []podcast.CategoryFilter
+}
+
+// TrendingPodcasts returns the podcasts which are currently trending in the index.
+//
+// Also accepts optional parameters to filter the results, see TrendingPodcastsParams for more details.
+func (c *Client) TrendingPodcasts(ctx context.Context, params *TrendingPodcastsParams) ([]*Podcast, error) {
+	var response searchResponse
+	urlParams := url.Values{"max": {"10"}}
+	if params != nil {
+		if params.Max != 0 {
+			urlParams.Set("max", strconv.Itoa(params.Max))
+		}
+		if !params.Since.IsZero() {
+			urlParams.Add("since", unixTime(params.Since))
+		}
+		if len(params.Languages) != 0 {
+			urlParams.Add("lang", joinLanguages(params.Languages))
+		}
+		if len(params.Categories) != 0 {
+			urlParams.Add("cat", joinCategories(params.Categories))
+		}
+		if len(params.ExcludeCategories) != 0 {
+			urlParams.Add("notcat", joinCategories(params.ExcludeCategories))
+		}
+	}
+	err := c.api.Get(ctx, "/podcasts/trending", urlParams, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Feeds, nil
+}
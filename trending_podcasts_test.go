@@ -0,0 +1,64 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+
+	"golang.org/x/text/language"
+)
+
+// TestTrendingPodcastsRequest verifies that the client sends the correct
+// request path and query parameters for the TrendingPodcasts method.
+func TestTrendingPodcastsRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		params := TrendingPodcastsParams{
+			Max:               5,
+			Since:             time.Unix(1613805000, 0),
+			Languages:         []language.Tag{language.English, language.Spanish},
+			Categories:        []podcast.CategoryFilter{podcast.CategoryID(9), podcast.Category{ID: 11, Name: "Careers"}},
+			ExcludeCategories: []podcast.CategoryFilter{podcast.CategoryID(86)},
+		}
+
+		expectedQuery := url.Values{
+			"max":    {"5"},
+			"since":  {"1613805000"},
+			"lang":   {"en,es"},
+			"cat":    {"9,11"},
+			"notcat": {"86"},
+		}
+
+		_, _ = client.TrendingPodcasts(context.Background(), &params)
+		if err := searchServer.ExpectPathAndQuery("/podcasts/trending", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).TrendingPodcasts(context.Background(), nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestTrendingPodcastsIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	podcasts, err := client.TrendingPodcasts(context.Background(), &TrendingPodcastsParams{Max: 5, Languages: []language.Tag{language.English}})
+	if err != nil {
+		t.Fatalf("TrendingPodcasts failed: %v", err)
+	}
+	if len(podcasts) == 0 {
+		t.Fatalf("No trending podcasts found")
+	}
+}
@@ -0,0 +1,25 @@
+package podcastindex
+
+import (
+	"github.com/jjgmckenzie/podcastindex/episode"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// PodcastValue is the "Value for Value" information for a podcast or episode, along with the identifiers it belongs to.
+//
+// https://podcastindex-org.github.io/docs-api/#tag--Value
+// They can be retrieved by Client.GetValueByFeedID(podcast.ID), Client.GetValueByITunesID(podcast.ITunesID),
+// Client.GetValueByPodcastGUID(podcast.GUID), Client.GetValueByEpisodeGUID(podcast.GUID, episode.GUID) or
+// Client.GetValuesByEpisodeGUIDs(map[podcast.GUID][]episode.GUID)
+type PodcastValue struct {
+	// FeedID is the internal PodcastIndex.org Feed ID; only reported when the lookup was made by feed ID.
+	FeedID podcast.ID
+	// FeedITunesID is the iTunes id of the feed; only reported when the lookup was made by iTunes ID.
+	FeedITunesID podcast.ITunesID
+	// FeedGUID is the podcast.GUID of the feed; only reported when the lookup was made by GUID.
+	FeedGUID podcast.GUID
+	// EpisodeGUID is the episode.GUID of the episode; only reported when the lookup was made by episode GUID.
+	EpisodeGUID episode.GUID
+	// Value is the "Value for Value" payment information for the podcast or episode.
+	Value podcast.Value
+}
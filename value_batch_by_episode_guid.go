@@ -0,0 +1,43 @@
+package podcastindex
+
+import (
+	"context"
+
+	"github.com/jjgmckenzie/podcastindex/episode"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// valueBatchResponse is the response from the value/batch/byepisodeguid endpoint on a 200/OK response.
+type valueBatchResponse struct {
+	// Status indicates API request status; either "true" or "false"
+	Status string `json:"status"`
+	// Value is the list of "Value for Value" information found for the requested episodes
+	Value []struct {
+		PodcastGUID string        `json:"podcastGuid"`
+		EpisodeGUID string        `json:"episodeGuid"`
+		Value       podcast.Value `json:"value"`
+	} `json:"value"`
+	//  Description is the description of the response
+	Description string `json:"description"`
+}
+
+// GetValuesByEpisodeGUIDs returns the "Value for Value" information for many episodes in a single request.
+//
+// guids maps the GUID of each podcast to the GUIDs of the episodes of that podcast to look up.
+// Episodes without "Value for Value" information are not included in the result.
+func (c *Client) GetValuesByEpisodeGUIDs(ctx context.Context, guids map[podcast.GUID][]episode.GUID) ([]PodcastValue, error) {
+	var response valueBatchResponse
+	err := c.api.Post(ctx, "/value/batch/byepisodeguid", nil, guids, &response)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]PodcastValue, 0, len(response.Value))
+	for _, v := range response.Value {
+		values = append(values, PodcastValue{
+			FeedGUID:    podcast.GUID(v.PodcastGUID),
+			EpisodeGUID: episode.GUID(v.EpisodeGUID),
+			Value:       v.Value,
+		})
+	}
+	return values, nil
+}
@@ -0,0 +1,58 @@
+package podcastindex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/jjgmckenzie/podcastindex/episode"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+func TestGetValuesByEpisodeGUIDsRequest(t *testing.T) {
+	t.Run("the client posts the GUIDs and returns the values found", func(t *testing.T) {
+		var requestedMethod, requestedPath string
+		var requestedBody map[string][]string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestedMethod = r.Method
+			requestedPath = r.URL.Path
+			_ = json.NewDecoder(r.Body).Decode(&requestedBody)
+			_, _ = w.Write([]byte(`{
+				"status": "true",
+				"value": [{"podcastGuid": "917393e3-1b1e-5cef-ace4-edaa54e1f810", "episodeGuid": "PC20-229", "value": {"model": {"type": "lightning", "method": "keysend"}, "destinations": []}}],
+				"description": "Found matching episodes"
+			}`))
+		}))
+		defer server.Close()
+
+		serverURL, _ := url.Parse(server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		values, err := client.GetValuesByEpisodeGUIDs(context.Background(), map[podcast.GUID][]episode.GUID{
+			testValuePodcastGUID: {testValueEpisodeGUID, "PC20-228"},
+		})
+		if err != nil {
+			t.Fatalf("GetValuesByEpisodeGUIDs failed: %v", err)
+		}
+		if requestedMethod != http.MethodPost || requestedPath != "/value/batch/byepisodeguid" {
+			t.Errorf("expected POST /value/batch/byepisodeguid, got %s %s", requestedMethod, requestedPath)
+		}
+		if guids := requestedBody[string(testValuePodcastGUID)]; len(guids) != 2 {
+			t.Errorf("expected 2 episode GUIDs to be posted, got %v", requestedBody)
+		}
+		if len(values) != 1 || values[0].FeedGUID != testValuePodcastGUID || values[0].EpisodeGUID != testValueEpisodeGUID {
+			t.Errorf("unexpected values: %+v", values)
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).GetValuesByEpisodeGUIDs(context.Background(), nil)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
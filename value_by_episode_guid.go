@@ -0,0 +1,22 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/jjgmckenzie/podcastindex/episode"
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// GetValueByEpisodeGUID returns the "Value for Value" information for the episode with the given GUID.
+//
+// As episode GUIDs are only unique within a feed, the GUID of the podcast the episode belongs to is also required.
+func (c *Client) GetValueByEpisodeGUID(ctx context.Context, podcastGUID podcast.GUID, episodeGUID episode.GUID) (*PodcastValue, error) {
+	var response valueResponse
+	params := url.Values{"podcastguid": {string(podcastGUID)}, "episodeguid": {string(episodeGUID)}}
+	err := c.api.Get(ctx, "/value/byepisodeguid", params, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &PodcastValue{FeedGUID: podcastGUID, EpisodeGUID: episodeGUID, Value: response.Value}, nil
+}
@@ -0,0 +1,47 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/jjgmckenzie/podcastindex/episode"
+)
+
+const testValueEpisodeGUID = episode.GUID("PC20-229")
+
+func TestGetValueByEpisodeGUIDRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		_, _ = client.GetValueByEpisodeGUID(context.Background(), testValuePodcastGUID, testValueEpisodeGUID)
+		expectedQuery := url.Values{
+			"podcastguid": {string(testValuePodcastGUID)},
+			"episodeguid": {string(testValueEpisodeGUID)},
+		}
+		if err := searchServer.ExpectPathAndQuery("/value/byepisodeguid", expectedQuery); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client returns the value along with the podcast and episode GUIDs", func(t *testing.T) {
+		v, err := GetJSONServer(t, testValueResponse).GetValueByEpisodeGUID(context.Background(), testValuePodcastGUID, testValueEpisodeGUID)
+		if err != nil {
+			t.Fatalf("GetValueByEpisodeGUID failed: %v", err)
+		}
+		if v.FeedGUID != testValuePodcastGUID || v.EpisodeGUID != testValueEpisodeGUID || len(v.Value.Destinations) != 1 {
+			t.Errorf("unexpected value: %+v", v)
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).GetValueByEpisodeGUID(context.Background(), testValuePodcastGUID, testValueEpisodeGUID)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
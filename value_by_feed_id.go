@@ -0,0 +1,20 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// GetValueByFeedID returns the "Value for Value" information for the podcast with the given feed ID.
+func (c *Client) GetValueByFeedID(ctx context.Context, feedID podcast.ID) (*PodcastValue, error) {
+	var response valueResponse
+	params := url.Values{"id": {strconv.Itoa(int(feedID))}}
+	err := c.api.Get(ctx, "/value/byfeedid", params, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &PodcastValue{FeedID: feedID, Value: response.Value}, nil
+}
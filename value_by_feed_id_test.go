@@ -0,0 +1,70 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+	"github.com/jjgmckenzie/podcastindex/podcast/value"
+)
+
+// testValueFeedID is the feed ID of "Podcasting 2.0", which has a value block.
+const testValueFeedID = podcast.ID(920666)
+const testValuePodcastGUID = podcast.GUID("917393e3-1b1e-5cef-ace4-edaa54e1f810")
+
+const testValueResponse = `{
+	"status": "true",
+	"query": {"id": "920666"},
+	"value": {
+		"model": {"type": "lightning", "method": "keysend", "suggested": "0.00000015000"},
+		"destinations": [{"name": "podcaster", "address": "03ae9f91a0cb8ff43840e3c322c4c61f019d8c1c3cea15a25cfc425ac605e61a4a", "type": "node", "split": 99}]
+	},
+	"description": "Found matching feed"
+}`
+
+func TestGetValueByFeedIDRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		_, _ = client.GetValueByFeedID(context.Background(), testValueFeedID)
+		if err := searchServer.ExpectPathAndQuery("/value/byfeedid", url.Values{"id": {"920666"}}); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client returns the value along with the feed ID", func(t *testing.T) {
+		v, err := GetJSONServer(t, testValueResponse).GetValueByFeedID(context.Background(), testValueFeedID)
+		if err != nil {
+			t.Fatalf("GetValueByFeedID failed: %v", err)
+		}
+		if v.FeedID != testValueFeedID {
+			t.Errorf("expected feed ID %d, got %d", testValueFeedID, v.FeedID)
+		}
+		if v.Value.Model.Type != value.PaymentLightning || len(v.Value.Destinations) != 1 {
+			t.Errorf("unexpected value: %+v", v.Value)
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).GetValueByFeedID(context.Background(), testValueFeedID)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestGetValueByFeedIDIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	v, err := client.GetValueByFeedID(context.Background(), testValueFeedID)
+	if err != nil {
+		t.Fatalf("GetValueByFeedID failed: %v", err)
+	}
+	if len(v.Value.Destinations) == 0 {
+		t.Fatalf("No value destinations found")
+	}
+}
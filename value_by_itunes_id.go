@@ -0,0 +1,19 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// GetValueByITunesID returns the "Value for Value" information for the podcast with the given iTunes ID.
+func (c *Client) GetValueByITunesID(ctx context.Context, itunesID podcast.ITunesID) (*PodcastValue, error) {
+	var response valueResponse
+	params := url.Values{"id": {string(itunesID)}}
+	err := c.api.Get(ctx, "/value/byitunesid", params, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &PodcastValue{FeedITunesID: itunesID, Value: response.Value}, nil
+}
@@ -0,0 +1,41 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+func TestGetValueByITunesIDRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		_, _ = client.GetValueByITunesID(context.Background(), podcast.ITunesID("1584274529"))
+		if err := searchServer.ExpectPathAndQuery("/value/byitunesid", url.Values{"id": {"1584274529"}}); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client returns the value along with the iTunes ID", func(t *testing.T) {
+		v, err := GetJSONServer(t, testValueResponse).GetValueByITunesID(context.Background(), podcast.ITunesID("1584274529"))
+		if err != nil {
+			t.Fatalf("GetValueByITunesID failed: %v", err)
+		}
+		if v.FeedITunesID != "1584274529" || len(v.Value.Destinations) != 1 {
+			t.Errorf("unexpected value: %+v", v)
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).GetValueByITunesID(context.Background(), podcast.ITunesID("1584274529"))
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
@@ -0,0 +1,19 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/jjgmckenzie/podcastindex/podcast"
+)
+
+// GetValueByPodcastGUID returns the "Value for Value" information for the podcast with the given GUID.
+func (c *Client) GetValueByPodcastGUID(ctx context.Context, guid podcast.GUID) (*PodcastValue, error) {
+	var response valueResponse
+	params := url.Values{"guid": {string(guid)}}
+	err := c.api.Get(ctx, "/value/bypodcastguid", params, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &PodcastValue{FeedGUID: guid, Value: response.Value}, nil
+}
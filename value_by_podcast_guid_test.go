@@ -0,0 +1,50 @@
+package podcastindex
+
+import (
+	"context"
+	"net/url"
+	"testing"
+)
+
+func TestGetValueByPodcastGUIDRequest(t *testing.T) {
+	t.Run("the client sends the correct request path and query parameters", func(t *testing.T) {
+		searchServer := GetSearchServer(t)
+		defer searchServer.Server.Close()
+
+		serverURL, _ := url.Parse(searchServer.Server.URL)
+		client := NewClient(NewClientOptions{
+			BaseURL: serverURL,
+		})
+
+		_, _ = client.GetValueByPodcastGUID(context.Background(), testValuePodcastGUID)
+		if err := searchServer.ExpectPathAndQuery("/value/bypodcastguid", url.Values{"guid": {string(testValuePodcastGUID)}}); err != nil {
+			t.Error(err.Error())
+		}
+	})
+	t.Run("the client returns the value along with the podcast GUID", func(t *testing.T) {
+		v, err := GetJSONServer(t, testValueResponse).GetValueByPodcastGUID(context.Background(), testValuePodcastGUID)
+		if err != nil {
+			t.Fatalf("GetValueByPodcastGUID failed: %v", err)
+		}
+		if v.FeedGUID != testValuePodcastGUID || len(v.Value.Destinations) != 1 {
+			t.Errorf("unexpected value: %+v", v)
+		}
+	})
+	t.Run("the client errors if the server returns an error", func(t *testing.T) {
+		_, err := GetErrorServer(t).GetValueByPodcastGUID(context.Background(), testValuePodcastGUID)
+		if err == nil {
+			t.Errorf("Expected an error when server returns status 500, but got nil")
+		}
+	})
+}
+
+func TestGetValueByPodcastGUIDIntegration(t *testing.T) {
+	client := authenticatedClient(t)
+	v, err := client.GetValueByPodcastGUID(context.Background(), testValuePodcastGUID)
+	if err != nil {
+		t.Fatalf("GetValueByPodcastGUID failed: %v", err)
+	}
+	if len(v.Value.Destinations) == 0 {
+		t.Fatalf("No value destinations found")
+	}
+}
@@ -0,0 +1,91 @@
+package podcastindex
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/jjgmckenzie/podcastindex/internal"
+)
+
+// WarningCode identifies the kind of a Warning.
+type WarningCode string
+
+const (
+	// WarningMaxTruncated is raised when a requested maximum number of results is higher than the API supports
+	// for an endpoint, despite its documentation, and the lower supported maximum was requested instead.
+	WarningMaxTruncated WarningCode = "max_truncated"
+)
+
+// Warning describes a quirk of the PodcastIndex API the library has worked around, e.g. by correcting a parameter.
+//
+// Warnings are logged to NewClientOptions.Logger, sent to NewClientOptions.Warnings where set, and collected by the
+// WarningCollector of the call's context, if any, so they can be inspected programmatically, e.g. per call.
+type Warning struct {
+	// Code identifies the kind of warning.
+	Code WarningCode
+	// Endpoint is the endpoint of the request the warning was raised for, e.g. /search/bytitle
+	Endpoint string
+	// Message describes the quirk, and what was done about it.
+	Message string
+	// Reference (Optional) is a link to where the quirk is documented.
+	Reference string
+}
+
+// String returns the message of the warning, followed by its reference if any.
+func (w Warning) String() string {
+	if w.Reference == "" {
+		return w.Message
+	}
+	return w.Message + "; see " + w.Reference
+}
+
+// WarningCollector collects the warnings raised by the calls made with a context from WithWarningCollector, e.g. to
+// inspect the warnings of a single call; it is safe for concurrent use.
+type WarningCollector struct {
+	mu       sync.Mutex
+	warnings []Warning
+}
+
+// Warnings returns the warnings collected so far, in the order they were raised.
+func (collector *WarningCollector) Warnings() []Warning {
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	return slices.Clone(collector.warnings)
+}
+
+type warningCollectorKey struct{}
+
+// WithWarningCollector returns a context collecting the warnings raised by the calls made with it into collector,
+// as well as logging them and sending them to NewClientOptions.Warnings. For example:
+//
+//	var warnings podcastindex.WarningCollector
+//	podcasts, err := client.SearchPodcastsByTitle(podcastindex.WithWarningCollector(ctx, &warnings), "batman", params)
+//	for _, warning := range warnings.Warnings() {
+//		fmt.Println(warning)
+//	}
+func WithWarningCollector(ctx context.Context, collector *WarningCollector) context.Context {
+	return context.WithValue(ctx, warningCollectorKey{}, collector)
+}
+
+// warn logs warning to the logger of the request it was raised for, sends it to the client's warnings channel, if
+// any, and adds it to the WarningCollector of ctx, if any.
+//
+// The send never blocks: if the channel is full, the warning is only logged and collected.
+func (c *Client) warn(ctx context.Context, method string, warning Warning) {
+	logger := internal.RequestLogger(c.logger, method, warning.Endpoint)
+	logger.WarnContext(ctx, warning.Message, "code", warning.Code, "reference", warning.Reference)
+	if collector, ok := ctx.Value(warningCollectorKey{}).(*WarningCollector); ok {
+		collector.mu.Lock()
+		collector.warnings = append(collector.warnings, warning)
+		collector.mu.Unlock()
+	}
+	if c.warnings == nil {
+		return
+	}
+	select {
+	case c.warnings <- warning:
+	default:
+		logger.DebugContext(ctx, "warnings channel is full, dropped warning", "code", warning.Code)
+	}
+}
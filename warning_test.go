@@ -0,0 +1,70 @@
+package podcastindex
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestWarningString(t *testing.T) {
+	warning := Warning{Message: "max truncated", Reference: "https://example.com/issue"}
+	if warning.String() != "max truncated; see https://example.com/issue" {
+		t.Errorf("unexpected warning string %q", warning.String())
+	}
+	warning.Reference = ""
+	if warning.String() != "max truncated" {
+		t.Errorf("unexpected warning string %q", warning.String())
+	}
+}
+
+func TestWarnDoesNotBlock(t *testing.T) {
+	var logs bytes.Buffer
+	warnings := make(chan Warning)
+	client := &Client{
+		logger:   slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		warnings: warnings,
+	}
+	client.warn(context.Background(), http.MethodGet, Warning{Code: WarningMaxTruncated, Message: "max truncated"})
+	if !strings.Contains(logs.String(), "dropped warning") {
+		t.Errorf("expected the dropped warning to be logged, got %s", logs.String())
+	}
+}
+
+func TestWarnWithoutLoggerDiscardsLogs(t *testing.T) {
+	var logs bytes.Buffer
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logs, nil)))
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+
+	NewClient(NewClientOptions{}).warn(context.Background(), http.MethodGet, Warning{Code: WarningMaxTruncated, Message: "max truncated"})
+	if logs.Len() != 0 {
+		t.Errorf("expected no logs without a Logger, got %s", logs.String())
+	}
+}
+
+func TestClientLogsRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status": "true", "feeds": []}`))
+	}))
+	t.Cleanup(server.Close)
+	serverURL, _ := url.Parse(server.URL)
+
+	var logs bytes.Buffer
+	client := NewClient(NewClientOptions{
+		BaseURL: serverURL,
+		Logger:  slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+	})
+	if _, err := client.Categories(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	for _, attr := range []string{`"method":"GET"`, `"endpoint":"/categories/list"`, `"status":200`} {
+		if !strings.Contains(logs.String(), attr) {
+			t.Errorf("expected the request to be logged with %s, got %s", attr, logs.String())
+		}
+	}
+}